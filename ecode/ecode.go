@@ -6,6 +6,27 @@ var (
 	ErrNullPointer = errors.New("null reference instance")
 	ErrNoCommand   = errors.New("no command")
 	ErrCombination = errors.New("invalid parameter combination passed")
+	ErrHTTPStatus  = errors.New("bad HTTP status")
+
+	ErrRateLimited    = errors.New("rate limit of NASA API exceeded")
+	ErrInvalidAPIKey  = errors.New("invalid NASA API key")
+	ErrDateOutOfRange = errors.New("date out of range")
+	ErrNotFound       = errors.New("not found")
+	ErrServerError    = errors.New("NASA API server error")
+	ErrNoRateLimit    = errors.New("no rate limit information in response")
+
+	ErrDateBeforeFirst  = errors.New("date is before the first APOD (1995-06-16)")
+	ErrFutureDate       = errors.New("date is in the future")
+	ErrEndBeforeStart   = errors.New("end date is before start date")
+	ErrCountOutOfRange  = errors.New("count must be between 1 and 100")
+	ErrInvalidDate      = errors.New("invalid date expression")
+	ErrRangeTooLong     = errors.New("date range is too long")
+	ErrInvalidParameter = errors.New("invalid parameter")
+
+	ErrFileTooLarge = errors.New("file size exceeds the limit")
+	ErrSizeMismatch = errors.New("downloaded size does not match Content-Length")
+
+	ErrPartialDownload = errors.New("some dates failed to download")
 )
 
 /* MIT License
@@ -0,0 +1,69 @@
+package facade
+
+import (
+	"github.com/goark/apod/service/api"
+	"github.com/goark/gocli/rwi"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+// newAPI returns cobra.Command instance for api sub-command
+func newAPI(ui *rwi.RWI) *cobra.Command {
+	apiCmd := &cobra.Command{
+		Use:   "api GET <path> [key=value...]",
+		Short: "Request any path of NASA API",
+		Long:  "Request any path of NASA API with the configured API key, and output response data (JSON data is pretty-printed).\nRate limit information of the API key is output to standard error.\nFor example: apod api GET /neo/rest/v1/neo/3542519",
+		Args:  cobra.MinimumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cli, err := makeClient(ui)
+			if err != nil {
+				return debugPrint(ui, err)
+			}
+			// local options
+			rawFlag, err := cmd.Flags().GetBool("raw")
+			if err != nil {
+				return debugPrint(ui, err)
+			}
+			req, err := api.New(cli, viper.GetString("api-key"), args[0], args[1], args[2:], rawFlag)
+			if err != nil {
+				return debugPrint(ui, err)
+			}
+
+			// request
+			r, err := req.Do(cmd.Context())
+			if rl, ok := req.Client().RateLimit(); ok {
+				_ = ui.OutputErrln("Rate limit:", rl.String())
+			}
+			if err != nil {
+				return debugPrint(ui, err)
+			}
+			return debugPrint(ui, ui.WriteFrom(r))
+		},
+	}
+	apiCmd.Flags().BoolP("raw", "", false, "output raw response data (no pretty-print)")
+
+	return apiCmd
+}
+
+/* MIT License
+ *
+ * Copyright 2023 Spiegel
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
@@ -0,0 +1,86 @@
+package facade
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/goark/errs"
+	"github.com/goark/gocli/rwi"
+	"github.com/spf13/cobra"
+)
+
+// newCache returns cobra.Command instance for cache sub-command
+func newCache(ui *rwi.RWI) *cobra.Command {
+	cacheCmd := &cobra.Command{
+		Use:   "cache",
+		Short: "Manage cache of APOD API responses",
+		Long:  "Manage cache of APOD API responses.",
+	}
+	cacheCmd.AddCommand(
+		&cobra.Command{
+			Use:   "stats",
+			Short: "Show statistics of cache",
+			Long:  "Show statistics of cache.",
+			RunE: func(cmd *cobra.Command, args []string) error {
+				stats, err := makeCache().Stats()
+				if err != nil {
+					return debugPrint(ui, err)
+				}
+				b, err := json.Marshal(stats)
+				if err != nil {
+					return debugPrint(ui, errs.Wrap(err))
+				}
+				return debugPrint(ui, ui.Outputln(string(b)))
+			},
+		},
+		&cobra.Command{
+			Use:   "clear",
+			Short: "Remove all cached data",
+			Long:  "Remove all cached data.",
+			RunE: func(cmd *cobra.Command, args []string) error {
+				count, err := makeCache().Clear()
+				if err != nil {
+					return debugPrint(ui, err)
+				}
+				return debugPrint(ui, ui.OutputErrln(fmt.Sprintf("removed %d entries", count)))
+			},
+		},
+		&cobra.Command{
+			Use:   "prune",
+			Short: "Remove expired cached data",
+			Long:  "Remove expired cached data.",
+			RunE: func(cmd *cobra.Command, args []string) error {
+				count, err := makeCache().Prune()
+				if err != nil {
+					return debugPrint(ui, err)
+				}
+				return debugPrint(ui, ui.OutputErrln(fmt.Sprintf("removed %d entries", count)))
+			},
+		},
+	)
+
+	return cacheCmd
+}
+
+/* MIT License
+ *
+ * Copyright 2023 Spiegel
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
@@ -0,0 +1,54 @@
+package facade
+
+import (
+	"github.com/goark/apod/ecode"
+	"github.com/goark/apod/nasaapi"
+	"github.com/goark/errs"
+	"github.com/spf13/viper"
+)
+
+// parseDateWindow returns date window from --date, --start-date and --end-date flags. Unset dates are zero.
+func parseDateWindow() (nasaapi.Date, nasaapi.Date, error) {
+	date, dateEnd, err := nasaapi.ParseDateRange(viper.GetString("date"))
+	if err != nil {
+		return nasaapi.Date{}, nasaapi.Date{}, errs.Wrap(err)
+	}
+	startDate, _, err := nasaapi.ParseDateRange(viper.GetString("start-date"))
+	if err != nil {
+		return nasaapi.Date{}, nasaapi.Date{}, errs.Wrap(err)
+	}
+	_, endDate, err := nasaapi.ParseDateRange(viper.GetString("end-date"))
+	if err != nil {
+		return nasaapi.Date{}, nasaapi.Date{}, errs.Wrap(err)
+	}
+	if !date.IsZero() {
+		if !startDate.IsZero() || !endDate.IsZero() {
+			return nasaapi.Date{}, nasaapi.Date{}, errs.Wrap(ecode.ErrCombination, errs.WithContext("date", date), errs.WithContext("start-date", startDate), errs.WithContext("end-date", endDate))
+		}
+		return date, dateEnd, nil
+	}
+	return startDate, endDate, nil
+}
+
+/* MIT License
+ *
+ * Copyright 2023 Spiegel
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
@@ -0,0 +1,124 @@
+package facade
+
+import (
+	"strings"
+
+	"github.com/goark/apod/nasaapi/donki"
+	sdonki "github.com/goark/apod/service/donki"
+	"github.com/goark/errs"
+	"github.com/goark/gocli/rwi"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+// newDonki returns cobra.Command instance for donki sub-command
+func newDonki(ui *rwi.RWI) *cobra.Command {
+	types := make([]string, 0, len(donki.EventTypes))
+	for _, typ := range donki.EventTypes {
+		types = append(types, string(typ))
+	}
+	donkiCmd := &cobra.Command{
+		Use:       "donki <event type>",
+		Short:     "List space weather events from DONKI",
+		Long:      "List space weather events from DONKI (Space Weather Database Of Notifications, Knowledge, Information).\nEvent type is one of " + strings.Join(types, ", ") + ".\nDate range is given by --date, --start-date and --end-date (default is last 30 days).",
+		Args:      cobra.ExactArgs(1),
+		ValidArgs: types,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			typ, err := donki.EventTypeFrom(args[0])
+			if err != nil {
+				return debugPrint(ui, err)
+			}
+			// global options
+			cfg, err := makeDonkiConfig(ui)
+			if err != nil {
+				return debugPrint(ui, err)
+			}
+			// local options
+			formatStr, err := cmd.Flags().GetString("format")
+			if err != nil {
+				return debugPrint(ui, err)
+			}
+			format, err := sdonki.FormatFrom(formatStr)
+			if err != nil {
+				return debugPrint(ui, err)
+			}
+			catalog, err := cmd.Flags().GetString("catalog")
+			if err != nil {
+				return debugPrint(ui, err)
+			}
+			location, err := cmd.Flags().GetString("location")
+			if err != nil {
+				return debugPrint(ui, err)
+			}
+			notificationType, err := cmd.Flags().GetString("notification-type")
+			if err != nil {
+				return debugPrint(ui, err)
+			}
+			for _, opt := range []donki.Opts{
+				donki.WithCatalog(catalog),
+				donki.WithLocation(location),
+				donki.WithNotificationType(notificationType),
+			} {
+				opt(cfg)
+			}
+
+			// list events
+			r, err := sdonki.New(cfg, typ, format).Do(cmd.Context())
+			if err != nil {
+				return debugPrint(ui, err)
+			}
+			defer r.Close()
+			if err := ui.WriteFrom(r); err != nil {
+				return debugPrint(ui, err)
+			}
+			warnRateLimit(ui, cfg.Client())
+			return nil
+		},
+	}
+	donkiCmd.Flags().StringP("format", "", "json", "output format (json, table or raw)")
+	donkiCmd.Flags().StringP("catalog", "", "", "catalog for CMEAnalysis and IPS (e.g. ALL, SWRC_CATALOG)")
+	donkiCmd.Flags().StringP("location", "", "", "location for IPS (e.g. ALL, Earth, STEREO A)")
+	donkiCmd.Flags().StringP("notification-type", "", "", "message type for notifications (e.g. all, FLR, CME, report)")
+
+	return donkiCmd
+}
+
+func makeDonkiConfig(ui *rwi.RWI) (*donki.Request, error) {
+	cli, err := makeClient(ui)
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	startDate, endDate, err := parseDateWindow()
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return donki.New(
+		donki.WithStartDate(startDate),
+		donki.WithEndDate(endDate),
+		donki.WithAPIKey(viper.GetString("api-key")),
+		donki.WithClient(cli),
+	), nil
+}
+
+/* MIT License
+ *
+ * Copyright 2023 Spiegel
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
@@ -1,9 +1,10 @@
 package facade
 
 import (
-	"context"
+	"os"
 
 	"github.com/goark/apod/service/download"
+	"github.com/goark/errs"
 	"github.com/goark/gocli/rwi"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
@@ -21,30 +22,77 @@ func newDownload(ui *rwi.RWI) *cobra.Command {
 			dir := viper.GetString("base-dir")
 			copyrightFlag := viper.GetBool("include-nopd")
 			overwriteFlag := viper.GetBool("overwrite")
-			cfg, err := makeAPODConfig()
+			jobs := viper.GetInt("jobs")
+			keepGoing := viper.GetBool("keep-going")
+			reportPath := viper.GetString("report")
+			maxSize, err := download.ParseSize(viper.GetString("max-size"))
+			if err != nil {
+				return debugPrint(ui, err)
+			}
+			cfg, err := makeAPODConfig(ui)
 			if err != nil {
 				return debugPrint(ui, err)
 			}
 
 			// download APOD data
-			if err := download.New(cfg, dir, copyrightFlag, overwriteFlag).Do(context.TODO()); err != nil {
+			dl := download.New(cfg, dir, copyrightFlag, overwriteFlag, download.WithJobs(jobs), download.WithMaxSize(maxSize), download.WithKeepGoing(keepGoing))
+			err = dl.Do(cmd.Context())
+			if report := dl.Report(); report != nil {
+				if keepGoing {
+					_ = report.WriteTable(ui.ErrorWriter())
+				}
+				if len(reportPath) > 0 {
+					if rerr := writeReport(report, reportPath); rerr != nil && err == nil {
+						err = rerr
+					}
+				}
+			}
+			if err != nil {
 				return debugPrint(ui, err)
 			}
+			warnRateLimit(ui, cfg.Client())
 			return nil
 		},
 	}
 	downloadCmd.Flags().StringP("base-dir", "d", "./apod", "Base directory for daownload")
 	downloadCmd.Flags().BoolP("include-nopd", "", false, "Download no public domain images or videos")
 	downloadCmd.Flags().BoolP("overwrite", "", false, "Overwrite Download files")
+	downloadCmd.Flags().IntP("jobs", "j", 1, "maximum number of dates downloaded concurrently")
+	downloadCmd.Flags().StringP("max-size", "", "1GB", "maximum size of a downloaded file (e.g. 500MB, 2GB)")
+	downloadCmd.Flags().BoolP("keep-going", "", false, "continue downloading other dates after a failure, and print summary table")
+	downloadCmd.Flags().StringP("report", "", "", "write JSON report of outcome of each date to the file")
 
 	//Bind config file
 	_ = viper.BindPFlag("base-dir", downloadCmd.Flags().Lookup("base-dir"))
 	_ = viper.BindPFlag("include-nopd", downloadCmd.Flags().Lookup("include-nopd"))
 	_ = viper.BindPFlag("overwrite", downloadCmd.Flags().Lookup("overwrite"))
+	_ = viper.BindPFlag("jobs", downloadCmd.Flags().Lookup("jobs"))
+	_ = viper.BindPFlag("max-size", downloadCmd.Flags().Lookup("max-size"))
+	_ = viper.BindPFlag("keep-going", downloadCmd.Flags().Lookup("keep-going"))
+	_ = viper.BindPFlag("report", downloadCmd.Flags().Lookup("report"))
 
 	return downloadCmd
 }
 
+// getMaxSize function returns value of --max-size flag of download sub-commands in bytes.
+func getMaxSize(cmd *cobra.Command) (int64, error) {
+	s, err := cmd.Flags().GetString("max-size")
+	if err != nil {
+		return 0, errs.Wrap(err)
+	}
+	return download.ParseSize(s)
+}
+
+// writeReport function writes JSON report of download command into the file.
+func writeReport(report *download.Report, path string) error {
+	file, err := os.Create(path)
+	if err != nil {
+		return errs.Wrap(err, errs.WithContext("path", path))
+	}
+	defer file.Close()
+	return errs.Wrap(report.EncodeJSON(file), errs.WithContext("path", path))
+}
+
 /* MIT License
  *
  * Copyright 2023 Spiegel
@@ -0,0 +1,209 @@
+package facade
+
+import (
+	"io"
+	"os"
+
+	"github.com/goark/apod/ecode"
+	"github.com/goark/apod/nasaapi/earth"
+	"github.com/goark/apod/service/download"
+	"github.com/goark/errs"
+	"github.com/goark/gocli/rwi"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+// newEarth returns cobra.Command instance for earth sub-command
+func newEarth(ui *rwi.RWI) *cobra.Command {
+	earthCmd := &cobra.Command{
+		Use:   "earth",
+		Short: "Look up Landsat 8 imagery of the location",
+		Long:  "Look up metadata (assets) of Landsat 8 imagery closest to the date (--date) for the location (--lat, --lon).",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cfg, err := makeEarthConfig(ui)
+			if err != nil {
+				return debugPrint(ui, err)
+			}
+			loc, err := makeEarthLocation(cmd)
+			if err != nil {
+				return debugPrint(ui, err)
+			}
+			if loc == nil {
+				return debugPrint(ui, errs.Wrap(ecode.ErrInvalidParameter, errs.WithContext("lat", nil), errs.WithContext("lon", nil)))
+			}
+			for _, opt := range loc.Opts() {
+				opt(cfg)
+			}
+
+			// get assets
+			assets, err := cfg.Assets(cmd.Context())
+			if err != nil {
+				return debugPrint(ui, err)
+			}
+			return debugPrint(ui, outputJSON(ui, cfg.Client(), assets))
+		},
+	}
+	earthCmd.PersistentFlags().Float64P("lat", "", 0, "latitude of the location")
+	earthCmd.PersistentFlags().Float64P("lon", "", 0, "longitude of the location")
+	earthCmd.PersistentFlags().Float64P("dim", "", 0, "width and height of image in degrees (default 0.025)")
+
+	earthDownloadCmd := &cobra.Command{
+		Use:   "download",
+		Short: "Download Landsat 8 imagery",
+		Long:  "Download Landsat 8 imagery of the location (--lat, --lon), or of locations listed in CSV file (--csv),\ninto <base dir>/<date>/<location name>/ directory with metadata.json file.\nColumns of CSV are lat, lon, date, dim and name (date, dim and name are optional), or in the order of the header record.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cfg, err := makeEarthConfig(ui)
+			if err != nil {
+				return debugPrint(ui, err)
+			}
+			// local options
+			csvPath, err := cmd.Flags().GetString("csv")
+			if err != nil {
+				return debugPrint(ui, err)
+			}
+			dir, err := cmd.Flags().GetString("base-dir")
+			if err != nil {
+				return debugPrint(ui, err)
+			}
+			overwriteFlag, err := cmd.Flags().GetBool("overwrite")
+			if err != nil {
+				return debugPrint(ui, err)
+			}
+			maxSize, err := getMaxSize(cmd)
+			if err != nil {
+				return debugPrint(ui, err)
+			}
+			loc, err := makeEarthLocation(cmd)
+			if err != nil {
+				return debugPrint(ui, err)
+			}
+			var locations []*earth.Location
+			switch {
+			case len(csvPath) > 0 && loc != nil:
+				return debugPrint(ui, errs.Wrap(ecode.ErrCombination, errs.WithContext("csv", csvPath), errs.WithContext("location", loc.String())))
+			case len(csvPath) > 0:
+				locations, err = readEarthLocations(ui, csvPath)
+				if err != nil {
+					return debugPrint(ui, err)
+				}
+				dim, err := cmd.Flags().GetFloat64("dim")
+				if err != nil {
+					return debugPrint(ui, err)
+				}
+				if dim > 0 {
+					for _, l := range locations {
+						if l.Dim == 0 {
+							l.Dim = dim
+						}
+					}
+				}
+			case loc != nil:
+				locations = []*earth.Location{loc}
+			default:
+				return debugPrint(ui, errs.Wrap(ecode.ErrInvalidParameter, errs.WithContext("csv", csvPath), errs.WithContext("lat", nil), errs.WithContext("lon", nil)))
+			}
+
+			// download Earth imagery
+			if err := download.NewEarth(cfg, locations, dir, overwriteFlag, download.WithFileMaxSize(maxSize)).Do(cmd.Context()); err != nil {
+				return debugPrint(ui, err)
+			}
+			warnRateLimit(ui, cfg.Client())
+			return nil
+		},
+	}
+	earthDownloadCmd.Flags().StringP("csv", "", "", "CSV file of locations (\"-\" is standard input)")
+	earthDownloadCmd.Flags().StringP("base-dir", "d", "./earth", "Base directory for download")
+	earthDownloadCmd.Flags().BoolP("overwrite", "", false, "Overwrite Download files")
+	earthDownloadCmd.Flags().StringP("max-size", "", "1GB", "maximum size of a downloaded file (e.g. 500MB, 2GB)")
+
+	earthCmd.AddCommand(earthDownloadCmd)
+	return earthCmd
+}
+
+// makeEarthConfig returns earth.Request instance from global options.
+func makeEarthConfig(ui *rwi.RWI) (*earth.Request, error) {
+	cli, err := makeClient(ui)
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	startDate, endDate, err := parseDateWindow()
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	if !endDate.IsZero() && !endDate.Equal(startDate.Time) {
+		return nil, errs.Wrap(ecode.ErrInvalidParameter, errs.WithContext("start-date", startDate), errs.WithContext("end-date", endDate))
+	}
+	return earth.New(
+		earth.WithDate(startDate),
+		earth.WithAPIKey(viper.GetString("api-key")),
+		earth.WithClient(cli),
+	), nil
+}
+
+// makeEarthLocation returns earth.Location instance from --lat, --lon and --dim options.
+// If neither --lat nor --lon is set, returns nil.
+func makeEarthLocation(cmd *cobra.Command) (*earth.Location, error) {
+	lat, err := cmd.Flags().GetFloat64("lat")
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	lon, err := cmd.Flags().GetFloat64("lon")
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	dim, err := cmd.Flags().GetFloat64("dim")
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	latFlag, lonFlag := cmd.Flags().Changed("lat"), cmd.Flags().Changed("lon")
+	if !latFlag && !lonFlag {
+		return nil, nil
+	}
+	if !latFlag || !lonFlag {
+		return nil, errs.Wrap(ecode.ErrCombination, errs.WithContext("lat", latFlag), errs.WithContext("lon", lonFlag))
+	}
+	return &earth.Location{Lat: lat, Lon: lon, Dim: dim}, nil
+}
+
+// readEarthLocations reads list of locations from CSV file.
+func readEarthLocations(ui *rwi.RWI, path string) ([]*earth.Location, error) {
+	var r io.Reader
+	if path == "-" {
+		r = ui.Reader()
+	} else {
+		file, err := os.Open(path)
+		if err != nil {
+			return nil, errs.Wrap(err, errs.WithContext("csv", path))
+		}
+		defer file.Close()
+		r = file
+	}
+	locations, err := earth.ReadLocations(r)
+	if err != nil {
+		return nil, errs.Wrap(err, errs.WithContext("csv", path))
+	}
+	return locations, nil
+}
+
+/* MIT License
+ *
+ * Copyright 2023 Spiegel
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
@@ -0,0 +1,208 @@
+package facade
+
+import (
+	"net/url"
+
+	"github.com/goark/apod/nasaapi/eonet"
+	"github.com/goark/errs"
+	"github.com/goark/gocli/rwi"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+// newEONET returns cobra.Command instance for eonet sub-command
+func newEONET(ui *rwi.RWI) *cobra.Command {
+	eonetCmd := &cobra.Command{
+		Use:   "eonet",
+		Short: "List natural events from EONET",
+		Long:  "List natural events from EONET (Earth Observatory Natural Event Tracker) as typed JSON or GeoJSON FeatureCollection.\nDate range is given by --date, --start-date and --end-date.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cfg, err := makeEONETConfig(ui)
+			if err != nil {
+				return debugPrint(ui, err)
+			}
+			// local options
+			opts, err := makeEONETFilters(cmd)
+			if err != nil {
+				return debugPrint(ui, err)
+			}
+			for _, opt := range opts {
+				opt(cfg)
+			}
+			geojsonFlag, err := cmd.Flags().GetBool("geojson")
+			if err != nil {
+				return debugPrint(ui, err)
+			}
+
+			// list events
+			if geojsonFlag {
+				fc, err := cfg.EventsGeoJSON(cmd.Context())
+				if err != nil {
+					return debugPrint(ui, err)
+				}
+				return debugPrint(ui, outputJSON(ui, cfg.Client(), fc))
+			}
+			events, err := cfg.Events(cmd.Context())
+			if err != nil {
+				return debugPrint(ui, err)
+			}
+			return debugPrint(ui, outputJSON(ui, cfg.Client(), events.Events))
+		},
+	}
+	eonetCmd.PersistentFlags().StringP("eonet-base-url", "", eonet.DefaultBaseURL, "base URL of EONET API")
+	eonetCmd.Flags().StringP("status", "", "", "status of events (open, closed or all; default open)")
+	eonetCmd.Flags().StringP("category", "", "", "category IDs of events (comma separated, e.g. wildfires,severeStorms)")
+	eonetCmd.Flags().StringP("source", "", "", "source IDs of events (comma separated, e.g. InciWeb,EO)")
+	eonetCmd.Flags().StringP("bbox", "", "", "bounding box of events (min_lon,max_lat,max_lon,min_lat)")
+	eonetCmd.Flags().IntP("days", "", 0, "number of prior days (cannot be used with date range)")
+	eonetCmd.Flags().IntP("limit", "", 0, "maximum number of events")
+	eonetCmd.Flags().BoolP("geojson", "", false, "output GeoJSON FeatureCollection")
+	_ = viper.BindPFlag("eonet-base-url", eonetCmd.PersistentFlags().Lookup("eonet-base-url"))
+
+	eonetCategoriesCmd := &cobra.Command{
+		Use:   "categories",
+		Short: "List categories of natural events",
+		Long:  "List categories of natural events.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cfg, err := makeEONETConfig(ui)
+			if err != nil {
+				return debugPrint(ui, err)
+			}
+			categories, err := cfg.Categories(cmd.Context())
+			if err != nil {
+				return debugPrint(ui, err)
+			}
+			return debugPrint(ui, outputJSON(ui, cfg.Client(), categories.Categories))
+		},
+	}
+
+	eonetSourcesCmd := &cobra.Command{
+		Use:   "sources",
+		Short: "List sources of natural events",
+		Long:  "List sources of natural events.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cfg, err := makeEONETConfig(ui)
+			if err != nil {
+				return debugPrint(ui, err)
+			}
+			sources, err := cfg.Sources(cmd.Context())
+			if err != nil {
+				return debugPrint(ui, err)
+			}
+			return debugPrint(ui, outputJSON(ui, cfg.Client(), sources.Sources))
+		},
+	}
+
+	eonetLayersCmd := &cobra.Command{
+		Use:   "layers [category]",
+		Short: "List web service layers of natural events",
+		Long:  "List web service layers (WMS, WMTS, ...) for the imagery related to natural events.",
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cfg, err := makeEONETConfig(ui)
+			if err != nil {
+				return debugPrint(ui, err)
+			}
+			category := ""
+			if len(args) > 0 {
+				category = args[0]
+			}
+			layers, err := cfg.Layers(cmd.Context(), category)
+			if err != nil {
+				return debugPrint(ui, err)
+			}
+			return debugPrint(ui, outputJSON(ui, cfg.Client(), layers.Categories))
+		},
+	}
+
+	eonetCmd.AddCommand(eonetCategoriesCmd, eonetSourcesCmd, eonetLayersCmd)
+	return eonetCmd
+}
+
+// makeEONETConfig returns eonet.Request instance from global options.
+func makeEONETConfig(ui *rwi.RWI) (*eonet.Request, error) {
+	cli, err := makeClient(ui)
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	baseURL, err := url.Parse(viper.GetString("eonet-base-url"))
+	if err != nil {
+		return nil, errs.Wrap(err, errs.WithContext("eonet-base-url", viper.GetString("eonet-base-url")))
+	}
+	return eonet.New(
+		eonet.WithBaseURL(baseURL),
+		eonet.WithClient(cli),
+	), nil
+}
+
+// makeEONETFilters returns option functions for filtering events.
+func makeEONETFilters(cmd *cobra.Command) ([]eonet.Opts, error) {
+	statusStr, err := cmd.Flags().GetString("status")
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	status, err := eonet.StatusFrom(statusStr)
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	category, err := cmd.Flags().GetString("category")
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	source, err := cmd.Flags().GetString("source")
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	bboxStr, err := cmd.Flags().GetString("bbox")
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	bbox, err := eonet.ParseBBox(bboxStr)
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	days, err := cmd.Flags().GetInt("days")
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	limit, err := cmd.Flags().GetInt("limit")
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	startDate, endDate, err := parseDateWindow()
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return []eonet.Opts{
+		eonet.WithStatus(status),
+		eonet.WithCategory(splitList(category)...),
+		eonet.WithSource(splitList(source)...),
+		eonet.WithBBox(bbox),
+		eonet.WithDays(days),
+		eonet.WithLimit(limit),
+		eonet.WithDateRange(startDate, endDate),
+	}, nil
+}
+
+/* MIT License
+ *
+ * Copyright 2023 Spiegel
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
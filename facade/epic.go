@@ -0,0 +1,165 @@
+package facade
+
+import (
+	"github.com/goark/apod/nasaapi"
+	"github.com/goark/apod/nasaapi/epic"
+	"github.com/goark/apod/service/download"
+	"github.com/goark/errs"
+	"github.com/goark/gocli/rwi"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+// newEPIC returns cobra.Command instance for epic sub-command
+func newEPIC(ui *rwi.RWI) *cobra.Command {
+	epicCmd := &cobra.Command{
+		Use:   "epic",
+		Short: "Look up EPIC (Earth Polychromatic Imaging Camera) images",
+		Long:  "Look up metadata of EPIC (Earth Polychromatic Imaging Camera) images.\nDate is given by --date, --start-date and --end-date (default is most recent date).",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cfg, dates, err := makeEPICConfig(ui, cmd)
+			if err != nil {
+				return debugPrint(ui, err)
+			}
+			var images []*epic.Image
+			if len(dates) == 0 {
+				list, err := cfg.Latest(cmd.Context())
+				if err != nil {
+					return debugPrint(ui, err)
+				}
+				images = list
+			}
+			for _, date := range dates {
+				epic.WithDate(date)(cfg)
+				list, err := cfg.Images(cmd.Context())
+				if err != nil {
+					return debugPrint(ui, err)
+				}
+				images = append(images, list...)
+			}
+			return debugPrint(ui, outputJSON(ui, cfg.Client(), images))
+		},
+	}
+	epicCmd.PersistentFlags().StringP("collection", "", string(epic.Natural), "collection of EPIC images (natural or enhanced)")
+
+	epicDatesCmd := &cobra.Command{
+		Use:   "dates",
+		Short: "List dates with available EPIC images",
+		Long:  "List dates with available EPIC images.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cfg, _, err := makeEPICConfig(ui, cmd)
+			if err != nil {
+				return debugPrint(ui, err)
+			}
+			dates, err := cfg.AvailableDates(cmd.Context())
+			if err != nil {
+				return debugPrint(ui, err)
+			}
+			return debugPrint(ui, outputJSON(ui, cfg.Client(), dates))
+		},
+	}
+
+	epicDownloadCmd := &cobra.Command{
+		Use:   "download",
+		Short: "Download EPIC images",
+		Long:  "Download EPIC images into <base dir>/<date>/<image name>/ directory with metadata.json file.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cfg, dates, err := makeEPICConfig(ui, cmd)
+			if err != nil {
+				return debugPrint(ui, err)
+			}
+			// local options
+			dir, err := cmd.Flags().GetString("base-dir")
+			if err != nil {
+				return debugPrint(ui, err)
+			}
+			formatStr, err := cmd.Flags().GetString("format")
+			if err != nil {
+				return debugPrint(ui, err)
+			}
+			format, err := epic.FormatFrom(formatStr)
+			if err != nil {
+				return debugPrint(ui, err)
+			}
+			overwriteFlag, err := cmd.Flags().GetBool("overwrite")
+			if err != nil {
+				return debugPrint(ui, err)
+			}
+			maxSize, err := getMaxSize(cmd)
+			if err != nil {
+				return debugPrint(ui, err)
+			}
+
+			// download EPIC images
+			if err := download.NewEPIC(cfg, dates, format, dir, overwriteFlag, download.WithFileMaxSize(maxSize)).Do(cmd.Context()); err != nil {
+				return debugPrint(ui, err)
+			}
+			warnRateLimit(ui, cfg.Client())
+			return nil
+		},
+	}
+	epicDownloadCmd.Flags().StringP("base-dir", "d", "./epic", "Base directory for download")
+	epicDownloadCmd.Flags().StringP("format", "", string(epic.PNG), "image format (png, jpg or thumbs)")
+	epicDownloadCmd.Flags().BoolP("overwrite", "", false, "Overwrite Download files")
+	epicDownloadCmd.Flags().StringP("max-size", "", "1GB", "maximum size of a downloaded file (e.g. 500MB, 2GB)")
+
+	epicCmd.AddCommand(epicDatesCmd, epicDownloadCmd)
+	return epicCmd
+}
+
+// makeEPICConfig returns epic.Request instance and list of dates from global options.
+func makeEPICConfig(ui *rwi.RWI, cmd *cobra.Command) (*epic.Request, []nasaapi.Date, error) {
+	cli, err := makeClient(ui)
+	if err != nil {
+		return nil, nil, errs.Wrap(err)
+	}
+	collectionStr, err := cmd.Flags().GetString("collection")
+	if err != nil {
+		return nil, nil, errs.Wrap(err)
+	}
+	collection, err := epic.CollectionFrom(collectionStr)
+	if err != nil {
+		return nil, nil, errs.Wrap(err)
+	}
+	startDate, endDate, err := parseDateWindow()
+	if err != nil {
+		return nil, nil, errs.Wrap(err)
+	}
+	var dates []nasaapi.Date
+	if !startDate.IsZero() {
+		if endDate.IsZero() {
+			endDate = startDate
+		}
+		for dt := startDate; !dt.After(endDate.Time); dt = nasaapi.NewDate(dt.AddDate(0, 0, 1)) {
+			dates = append(dates, dt)
+		}
+	}
+	return epic.New(
+		epic.WithCollection(collection),
+		epic.WithAPIKey(viper.GetString("api-key")),
+		epic.WithClient(cli),
+	), dates, nil
+}
+
+/* MIT License
+ *
+ * Copyright 2023 Spiegel
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
@@ -0,0 +1,98 @@
+package facade
+
+import (
+	"net/url"
+	"strings"
+
+	"github.com/goark/apod/nasaapi/exoplanet"
+	"github.com/goark/errs"
+	"github.com/goark/gocli/rwi"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+// newExoplanet returns cobra.Command instance for exoplanet sub-command
+func newExoplanet(ui *rwi.RWI) *cobra.Command {
+	exoplanetCmd := &cobra.Command{
+		Use:   "exoplanet <ADQL query...>",
+		Short: "Query NASA Exoplanet Archive",
+		Long:  "Query NASA Exoplanet Archive by ADQL through TAP (Table Access Protocol) service.\nFor example: apod exoplanet \"select pl_name,hostname,disc_year from ps where default_flag=1 and disc_year=2023\"",
+		Args:  cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cli, err := makeClient(ui)
+			if err != nil {
+				return debugPrint(ui, err)
+			}
+			baseURL, err := url.Parse(viper.GetString("exoplanet-base-url"))
+			if err != nil {
+				return debugPrint(ui, errs.Wrap(err, errs.WithContext("exoplanet-base-url", viper.GetString("exoplanet-base-url"))))
+			}
+			// local options
+			formatStr, err := cmd.Flags().GetString("format")
+			if err != nil {
+				return debugPrint(ui, err)
+			}
+			format, err := exoplanet.FormatFrom(formatStr)
+			if err != nil {
+				return debugPrint(ui, err)
+			}
+			rawFlag, err := cmd.Flags().GetBool("raw")
+			if err != nil {
+				return debugPrint(ui, err)
+			}
+			cfg := exoplanet.New(
+				exoplanet.WithQuery(strings.Join(args, " ")),
+				exoplanet.WithFormat(format),
+				exoplanet.WithBaseURL(baseURL),
+				exoplanet.WithClient(cli),
+			)
+
+			// run query
+			if rawFlag {
+				r, err := cfg.GetRawData(cmd.Context())
+				if err != nil {
+					return debugPrint(ui, err)
+				}
+				defer r.Close()
+				return debugPrint(ui, ui.WriteFrom(r))
+			}
+			res, err := cfg.Do(cmd.Context())
+			if err != nil {
+				return debugPrint(ui, err)
+			}
+			if format == exoplanet.JSON {
+				return debugPrint(ui, outputJSON(ui, cfg.Client(), res.Rows))
+			}
+			return debugPrint(ui, res.WriteCSV(ui.Writer()))
+		},
+	}
+	exoplanetCmd.PersistentFlags().StringP("exoplanet-base-url", "", exoplanet.DefaultBaseURL, "base URL of NASA Exoplanet Archive")
+	exoplanetCmd.Flags().StringP("format", "", string(exoplanet.CSV), "output format (csv or json)")
+	exoplanetCmd.Flags().BoolP("raw", "", false, "output raw data from TAP service")
+	_ = viper.BindPFlag("exoplanet-base-url", exoplanetCmd.PersistentFlags().Lookup("exoplanet-base-url"))
+
+	return exoplanetCmd
+}
+
+/* MIT License
+ *
+ * Copyright 2023 Spiegel
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
@@ -2,16 +2,21 @@ package facade
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"net/url"
 	"os"
 	"os/signal"
 	"runtime"
+	"time"
 
 	"github.com/goark/errs"
 
 	"github.com/goark/apod/ecode"
 	"github.com/goark/apod/nasaapi"
 	"github.com/goark/apod/nasaapi/apod"
+	"github.com/goark/apod/nasaapi/cache"
+	usercache "github.com/goark/gocli/cache"
 	"github.com/goark/gocli/config"
 	"github.com/goark/gocli/exitcode"
 	"github.com/goark/gocli/rwi"
@@ -26,11 +31,15 @@ var (
 	Version = "dev-version"
 )
 
+// PartialSuccess is OS exit code when download command with --keep-going flag failed for some dates only.
+const PartialSuccess exitcode.ExitCode = 2
+
 var (
 	debugFlag         bool   //debug flag
 	cfgFile           string //config file
 	configFile        = "config"
 	defaultConfigPath = config.Path(Name, configFile+".yaml")
+	defaultCacheDir   = usercache.Dir(Name)
 )
 
 // newRootCmd returns cobra.Command instance for root command
@@ -45,19 +54,47 @@ func newRootCmd(ui *rwi.RWI, args []string) *cobra.Command {
 	}
 	// global options (binding)
 	rootCmd.PersistentFlags().StringP("api-key", "", "", "NASA API key")
-	rootCmd.PersistentFlags().StringP("date", "", "", "date of the APOD image to retrieve (YYYY-MM-DD)")
-	rootCmd.PersistentFlags().StringP("start-date", "", "", "start of a date range (YYYY-MM-DD)")
-	rootCmd.PersistentFlags().StringP("end-date", "", "", "end of a date range (YYYY-MM-DD)")
+	rootCmd.PersistentFlags().StringP("date", "", "", "date of the APOD image to retrieve (YYYY-MM-DD, YYYYMMDD, YYMMDD, today, yesterday, -7d, last-monday, or YYYY-MM/YYYY as a range)")
+	rootCmd.PersistentFlags().StringP("start-date", "", "", "start of a date range (same expressions as --date)")
+	rootCmd.PersistentFlags().StringP("end-date", "", "", "end of a date range (same expressions as --date)")
+	rootCmd.PersistentFlags().StringP("month", "", "", "whole month of APOD images to retrieve (YYYY-MM)")
+	rootCmd.PersistentFlags().StringP("year", "", "", "whole year of APOD images to retrieve (YYYY)")
 	rootCmd.PersistentFlags().IntP("count", "", 0, "count randomly chosen images")
 	rootCmd.PersistentFlags().BoolP("thumbs", "", false, "return the URL of video thumbnail")
+	rootCmd.PersistentFlags().IntP("chunk-days", "", apod.DefaultChunkDays, "split a date range into chunks of this number of days (negative is no split)")
+	rootCmd.PersistentFlags().IntP("chunk-concurrency", "", 1, "maximum number of chunks fetched concurrently")
+	rootCmd.PersistentFlags().StringP("base-url", "", nasaapi.DefaultBaseURL, "base URL of NASA API")
+	rootCmd.PersistentFlags().StringP("user-agent", "", "", fmt.Sprintf("User-Agent header for HTTP requests (default %s/%s)", Name, Version))
+	rootCmd.PersistentFlags().DurationP("timeout", "", 0, "timeout for each HTTP request (0 is no timeout)")
+	rootCmd.PersistentFlags().IntP("retry", "", nasaapi.DefaultRetryPolicy().MaxAttempts, "maximum number of attempts for transient failures (1 is no retry)")
+	rootCmd.PersistentFlags().DurationP("retry-backoff", "", nasaapi.DefaultRetryPolicy().MinBackoff, "backoff before the first retry")
+	rootCmd.PersistentFlags().DurationP("retry-max-backoff", "", nasaapi.DefaultRetryPolicy().MaxBackoff, "maximum backoff between retries")
+	rootCmd.PersistentFlags().IntP("rate-limit", "", 0, "client-side limit of requests per hour for the API key (0 is known limit of the key, negative is no limit)")
+	rootCmd.PersistentFlags().BoolP("no-cache", "", false, "do not use cache of APOD API responses")
+	rootCmd.PersistentFlags().StringP("cache-dir", "", "", fmt.Sprintf("cache directory (default %v)", defaultCacheDir))
+	rootCmd.PersistentFlags().IntP("quota-warning", "", 5, "warn when remaining quota of NASA API key drops below this value (0 is no warning)")
 
 	//Bind config file
 	_ = viper.BindPFlag("api-key", rootCmd.PersistentFlags().Lookup("api-key"))
 	_ = viper.BindPFlag("date", rootCmd.PersistentFlags().Lookup("date"))
 	_ = viper.BindPFlag("start-date", rootCmd.PersistentFlags().Lookup("start-date"))
 	_ = viper.BindPFlag("end-date", rootCmd.PersistentFlags().Lookup("end-date"))
+	_ = viper.BindPFlag("month", rootCmd.PersistentFlags().Lookup("month"))
+	_ = viper.BindPFlag("year", rootCmd.PersistentFlags().Lookup("year"))
 	_ = viper.BindPFlag("count", rootCmd.PersistentFlags().Lookup("count"))
 	_ = viper.BindPFlag("thumbs", rootCmd.PersistentFlags().Lookup("thumbs"))
+	_ = viper.BindPFlag("chunk-days", rootCmd.PersistentFlags().Lookup("chunk-days"))
+	_ = viper.BindPFlag("chunk-concurrency", rootCmd.PersistentFlags().Lookup("chunk-concurrency"))
+	_ = viper.BindPFlag("base-url", rootCmd.PersistentFlags().Lookup("base-url"))
+	_ = viper.BindPFlag("user-agent", rootCmd.PersistentFlags().Lookup("user-agent"))
+	_ = viper.BindPFlag("timeout", rootCmd.PersistentFlags().Lookup("timeout"))
+	_ = viper.BindPFlag("retry", rootCmd.PersistentFlags().Lookup("retry"))
+	_ = viper.BindPFlag("retry-backoff", rootCmd.PersistentFlags().Lookup("retry-backoff"))
+	_ = viper.BindPFlag("retry-max-backoff", rootCmd.PersistentFlags().Lookup("retry-max-backoff"))
+	_ = viper.BindPFlag("rate-limit", rootCmd.PersistentFlags().Lookup("rate-limit"))
+	_ = viper.BindPFlag("no-cache", rootCmd.PersistentFlags().Lookup("no-cache"))
+	_ = viper.BindPFlag("cache-dir", rootCmd.PersistentFlags().Lookup("cache-dir"))
+	_ = viper.BindPFlag("quota-warning", rootCmd.PersistentFlags().Lookup("quota-warning"))
 	cobra.OnInitialize(initConfig)
 
 	// global options (other)
@@ -74,32 +111,143 @@ func newRootCmd(ui *rwi.RWI, args []string) *cobra.Command {
 		newVersionCmd(ui),
 		newLookup(ui),
 		newDownload(ui),
+		newQuota(ui),
+		newCache(ui),
+		newNeo(ui),
+		newDonki(ui),
+		newEPIC(ui),
+		newMars(ui),
+		newImages(ui),
+		newEONET(ui),
+		newSSD(ui),
+		newEarth(ui),
+		newTechPort(ui),
+		newExoplanet(ui),
+		newAPI(ui),
 	)
 
 	return rootCmd
 }
 
-func makeAPODConfig() (*apod.Request, error) {
-	date, err := nasaapi.DateFrom(viper.GetString("date"))
+func makeClient(ui *rwi.RWI) (*nasaapi.Client, error) {
+	baseURL, err := url.Parse(viper.GetString("base-url"))
+	if err != nil {
+		return nil, errs.Wrap(err, errs.WithContext("base-url", viper.GetString("base-url")))
+	}
+	ua := viper.GetString("user-agent")
+	if len(ua) == 0 {
+		ua = Name + "/" + Version
+	}
+	apiKey := viper.GetString("api-key")
+	if len(apiKey) == 0 {
+		apiKey = nasaapi.DefaultAPIKey
+	}
+	limiter := nasaapi.NewKeyLimiter()
+	if limit := viper.GetInt("rate-limit"); limit < 0 {
+		limiter.Set(apiKey, nil)
+	} else if limit > 0 {
+		limiter.Set(apiKey, nasaapi.NewLimiter(limit, time.Hour, limit/10))
+	}
+	opts := []nasaapi.ClientOpts{
+		nasaapi.WithBaseURL(baseURL),
+		nasaapi.WithUserAgent(ua),
+		nasaapi.WithTimeout(viper.GetDuration("timeout")),
+		nasaapi.WithKeyLimiter(limiter),
+		nasaapi.WithRetryPolicy(nasaapi.RetryPolicy{
+			MaxAttempts: viper.GetInt("retry"),
+			MinBackoff:  viper.GetDuration("retry-backoff"),
+			MaxBackoff:  viper.GetDuration("retry-max-backoff"),
+		}),
+	}
+	if debugFlag {
+		opts = append(opts, nasaapi.WithRetryNotify(func(attempt int, wait time.Duration, err error) {
+			_ = ui.OutputErrln(fmt.Sprintf("retry: attempt %d failed (%v), waiting %v", attempt, err, wait))
+		}))
+	}
+	return nasaapi.NewClient(opts...), nil
+}
+
+func makeAPODConfig(ui *rwi.RWI) (*apod.Request, error) {
+	cli, err := makeClient(ui)
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	date, dateEnd, err := nasaapi.ParseDateRange(viper.GetString("date"))
 	if err != nil {
 		return nil, errs.Wrap(err)
 	}
-	startDate, err := nasaapi.DateFrom(viper.GetString("start-date"))
+	startDate, _, err := nasaapi.ParseDateRange(viper.GetString("start-date"))
 	if err != nil {
 		return nil, errs.Wrap(err)
 	}
-	endDate, err := nasaapi.DateFrom(viper.GetString("end-date"))
+	_, endDate, err := nasaapi.ParseDateRange(viper.GetString("end-date"))
 	if err != nil {
 		return nil, errs.Wrap(err)
 	}
-	return apod.New(
+	rangeOpt, err := makeRangeOpt(date, startDate, endDate)
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	if !date.Equal(dateEnd.Time) && startDate.IsZero() && endDate.IsZero() {
+		// month or year shorthand (e.g. --date 2023-02)
+		rangeOpt, date = apod.WithRange(date, dateEnd), nasaapi.Date{}
+	}
+	var c *cache.Cache
+	if !viper.GetBool("no-cache") {
+		c = makeCache()
+	}
+	cfg := apod.New(
 		apod.WithDate(date),
 		apod.WithStartDate(startDate),
 		apod.WithEndDate(endDate),
 		apod.WithCount(viper.GetInt("count")),
 		apod.WithThumbs(viper.GetBool("thumbs")),
 		apod.WithAPIKey(viper.GetString("api-key")),
-	), nil
+		apod.WithClient(cli),
+		apod.WithCache(c),
+		rangeOpt,
+		apod.WithChunkDays(viper.GetInt("chunk-days")),
+		apod.WithConcurrency(viper.GetInt("chunk-concurrency")),
+	)
+	if err := cfg.Validate(); err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return cfg, nil
+}
+
+// makeRangeOpt returns option function for --month or --year flag.
+func makeRangeOpt(date, startDate, endDate nasaapi.Date) (apod.Opts, error) {
+	month := viper.GetString("month")
+	year := viper.GetString("year")
+	if len(month) == 0 && len(year) == 0 {
+		return func(*apod.Request) {}, nil
+	}
+	if (len(month) > 0 && len(year) > 0) || !date.IsZero() || !startDate.IsZero() || !endDate.IsZero() || viper.GetInt("count") != 0 {
+		if len(month) > 0 {
+			return nil, errs.Wrap(&apod.ValidationError{Param: "month", Value: month, Err: ecode.ErrCombination})
+		}
+		return nil, errs.Wrap(&apod.ValidationError{Param: "year", Value: year, Err: ecode.ErrCombination})
+	}
+	if len(month) > 0 {
+		tm, err := time.Parse("2006-01", month)
+		if err != nil {
+			return nil, errs.Wrap(ecode.ErrInvalidDate, errs.WithCause(err), errs.WithContext("month", month))
+		}
+		return apod.WithMonth(tm.Year(), tm.Month()), nil
+	}
+	tm, err := time.Parse("2006", year)
+	if err != nil {
+		return nil, errs.Wrap(ecode.ErrInvalidDate, errs.WithCause(err), errs.WithContext("year", year))
+	}
+	return apod.WithYear(tm.Year()), nil
+}
+
+func makeCache() *cache.Cache {
+	dir := viper.GetString("cache-dir")
+	if len(dir) == 0 {
+		dir = defaultCacheDir
+	}
+	return cache.New(dir)
 }
 
 // initConfig reads in config file and ENV variables if set.
@@ -144,6 +292,9 @@ func Execute(ui *rwi.RWI, args []string) (exit exitcode.ExitCode) {
 	defer cancel()
 	if err := newRootCmd(ui, args).ExecuteContext(ctx); err != nil {
 		exit = exitcode.Abnormal
+		if errors.Is(err, ecode.ErrPartialDownload) {
+			exit = PartialSuccess
+		}
 	}
 	return
 }
@@ -0,0 +1,193 @@
+package facade
+
+import (
+	"net/url"
+	"strings"
+
+	"github.com/goark/apod/ecode"
+	"github.com/goark/apod/nasaapi/images"
+	"github.com/goark/apod/service/download"
+	"github.com/goark/errs"
+	"github.com/goark/gocli/rwi"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+// newImages returns cobra.Command instance for images sub-command
+func newImages(ui *rwi.RWI) *cobra.Command {
+	imagesCmd := &cobra.Command{
+		Use:   "images",
+		Short: "Search NASA Image and Video Library",
+		Long:  "Search and download media of NASA Image and Video Library (images-api.nasa.gov).",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return debugPrint(ui, errs.Wrap(ecode.ErrNoCommand))
+		},
+	}
+	imagesCmd.PersistentFlags().StringP("images-base-url", "", images.DefaultBaseURL, "base URL of NASA Image and Video Library API")
+	imagesCmd.PersistentFlags().StringP("media-type", "", "", "media types (image, video, audio; comma separated)")
+	imagesCmd.PersistentFlags().StringP("center", "", "", "NASA center which published the media (e.g. JSC, GSFC)")
+	imagesCmd.PersistentFlags().StringP("keywords", "", "", "keywords (comma separated)")
+	imagesCmd.PersistentFlags().IntP("year-start", "", 0, "start year of media creation")
+	imagesCmd.PersistentFlags().IntP("year-end", "", 0, "end year of media creation")
+	imagesCmd.PersistentFlags().IntP("page", "", 0, "page number of search results (1 origin)")
+	imagesCmd.PersistentFlags().IntP("page-size", "", 0, "number of search results per page (default 100)")
+	_ = viper.BindPFlag("images-base-url", imagesCmd.PersistentFlags().Lookup("images-base-url"))
+
+	imagesSearchCmd := &cobra.Command{
+		Use:   "search [query...]",
+		Short: "Search media in NASA Image and Video Library",
+		Long:  "Search media in NASA Image and Video Library.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cfg, err := makeImagesConfig(ui, cmd, strings.Join(args, " "))
+			if err != nil {
+				return debugPrint(ui, err)
+			}
+			// local options
+			pages, err := cmd.Flags().GetInt("pages")
+			if err != nil {
+				return debugPrint(ui, err)
+			}
+
+			// search media
+			items := []*images.Item{}
+			if err := cfg.SearchAll(cmd.Context(), func(c *images.Collection) bool {
+				items = append(items, c.Items...)
+				pages--
+				return pages != 0
+			}); err != nil {
+				return debugPrint(ui, err)
+			}
+			return debugPrint(ui, outputJSON(ui, cfg.Client(), items))
+		},
+	}
+	imagesSearchCmd.Flags().IntP("pages", "", 1, "number of pages to search (0 is all pages)")
+
+	imagesDownloadCmd := &cobra.Command{
+		Use:   "download [NASA ID...]",
+		Short: "Download media of NASA Image and Video Library",
+		Long:  "Download media of NASA Image and Video Library into <base dir>/<date created>/<NASA ID>/ directory with metadata.json file.\nIf NASA ID is not set, downloads a page of search results (--query and other search flags).",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// local options
+			query, err := cmd.Flags().GetString("query")
+			if err != nil {
+				return debugPrint(ui, err)
+			}
+			dir, err := cmd.Flags().GetString("base-dir")
+			if err != nil {
+				return debugPrint(ui, err)
+			}
+			size, err := cmd.Flags().GetString("size")
+			if err != nil {
+				return debugPrint(ui, err)
+			}
+			overwriteFlag, err := cmd.Flags().GetBool("overwrite")
+			if err != nil {
+				return debugPrint(ui, err)
+			}
+			maxSize, err := getMaxSize(cmd)
+			if err != nil {
+				return debugPrint(ui, err)
+			}
+			cfg, err := makeImagesConfig(ui, cmd, query)
+			if err != nil {
+				return debugPrint(ui, err)
+			}
+
+			// download media
+			return debugPrint(ui, download.NewImages(cfg, args, size, dir, overwriteFlag, download.WithFileMaxSize(maxSize)).Do(cmd.Context()))
+		},
+	}
+	imagesDownloadCmd.Flags().StringP("query", "q", "", "free text search terms (used if NASA ID is not set)")
+	imagesDownloadCmd.Flags().StringP("size", "", "orig", "size of media file (orig, large, medium, small or thumb)")
+	imagesDownloadCmd.Flags().StringP("base-dir", "d", "./images", "Base directory for download")
+	imagesDownloadCmd.Flags().BoolP("overwrite", "", false, "Overwrite Download files")
+	imagesDownloadCmd.Flags().StringP("max-size", "", "1GB", "maximum size of a downloaded file (e.g. 500MB, 2GB)")
+
+	imagesCmd.AddCommand(imagesSearchCmd, imagesDownloadCmd)
+	return imagesCmd
+}
+
+// makeImagesConfig returns images.Request instance from options.
+func makeImagesConfig(ui *rwi.RWI, cmd *cobra.Command, query string) (*images.Request, error) {
+	cli, err := makeClient(ui)
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	baseURL, err := url.Parse(viper.GetString("images-base-url"))
+	if err != nil {
+		return nil, errs.Wrap(err, errs.WithContext("images-base-url", viper.GetString("images-base-url")))
+	}
+	mediaType, err := cmd.Flags().GetString("media-type")
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	center, err := cmd.Flags().GetString("center")
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	keywords, err := cmd.Flags().GetString("keywords")
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	yearStart, err := cmd.Flags().GetInt("year-start")
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	yearEnd, err := cmd.Flags().GetInt("year-end")
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	page, err := cmd.Flags().GetInt("page")
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	pageSize, err := cmd.Flags().GetInt("page-size")
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return images.New(
+		images.WithQuery(query),
+		images.WithMediaType(splitList(mediaType)...),
+		images.WithCenter(center),
+		images.WithKeywords(splitList(keywords)...),
+		images.WithYears(yearStart, yearEnd),
+		images.WithPage(page),
+		images.WithPageSize(pageSize),
+		images.WithBaseURL(baseURL),
+		images.WithClient(cli),
+	), nil
+}
+
+// splitList returns list of comma separated string.
+func splitList(s string) []string {
+	var list []string
+	for _, elm := range strings.Split(s, ",") {
+		if elm = strings.TrimSpace(elm); len(elm) > 0 {
+			list = append(list, elm)
+		}
+	}
+	return list
+}
+
+/* MIT License
+ *
+ * Copyright 2023 Spiegel
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
@@ -15,7 +15,7 @@ func newLookup(ui *rwi.RWI) *cobra.Command {
 		Long:    "Look up NASA APOD data.",
 		RunE: func(cmd *cobra.Command, args []string) error {
 			// global options
-			cfg, err := makeAPODConfig()
+			cfg, err := makeAPODConfig(ui)
 			if err != nil {
 				return debugPrint(ui, err)
 			}
@@ -31,7 +31,11 @@ func newLookup(ui *rwi.RWI) *cobra.Command {
 				return debugPrint(ui, err)
 			}
 			defer r.Close()
-			return debugPrint(ui, ui.WriteFrom(r))
+			if err := ui.WriteFrom(r); err != nil {
+				return debugPrint(ui, err)
+			}
+			warnRateLimit(ui, cfg.Client())
+			return nil
 		},
 	}
 	lookupCmd.Flags().BoolP("raw", "", false, "Output raw data from APOD API")
@@ -0,0 +1,61 @@
+package facade
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/goark/gocli/exitcode"
+	"github.com/goark/gocli/rwi"
+)
+
+func TestLookupAPIError(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(`{"code":400,"msg":"Date must be between Jun 16, 1995 and Feb 25, 2023.","service_version":"v1"}`))
+	}))
+	defer ts.Close()
+	result := "Error: Date must be between Jun 16, 1995 and Feb 25, 2023. (HTTP 400)\n"
+
+	outBuf := new(bytes.Buffer)
+	outErrBuf := new(bytes.Buffer)
+	ui := rwi.New(rwi.WithWriter(outBuf), rwi.WithErrorWriter(outErrBuf))
+	args := []string{"lookup", "--base-url", ts.URL, "--date", "2023-02-24"}
+
+	exit := Execute(ui, args)
+	if exit != exitcode.Abnormal {
+		t.Errorf("Execute(lookup) = \"%v\", want \"%v\".", exit, exitcode.Abnormal)
+	}
+	str := outBuf.String()
+	if str != "" {
+		t.Errorf("Execute(lookup) = \"%v\", want \"%v\".", str, "")
+	}
+	str = outErrBuf.String()
+	if str != result {
+		t.Errorf("Execute(lookup) = \"%v\", want \"%v\".", str, result)
+	}
+}
+
+/* MIT License
+ *
+ * Copyright 2023 Spiegel
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
@@ -0,0 +1,156 @@
+package facade
+
+import (
+	"github.com/goark/apod/nasaapi/marsrover"
+	"github.com/goark/apod/service/download"
+	"github.com/goark/errs"
+	"github.com/goark/gocli/rwi"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+// newMars returns cobra.Command instance for mars sub-command
+func newMars(ui *rwi.RWI) *cobra.Command {
+	marsCmd := &cobra.Command{
+		Use:   "mars",
+		Short: "Look up Mars rover photos",
+		Long:  "Look up Mars rover photos by --sol or --date (earth date). If neither is set, looks up latest photos.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cfg, err := makeMarsConfig(ui, cmd)
+			if err != nil {
+				return debugPrint(ui, err)
+			}
+			photos, err := cfg.Photos(cmd.Context())
+			if err != nil {
+				return debugPrint(ui, err)
+			}
+			return debugPrint(ui, outputJSON(ui, cfg.Client(), photos))
+		},
+	}
+	marsCmd.PersistentFlags().StringP("rover", "", string(marsrover.Curiosity), "name of Mars rover (curiosity, opportunity, spirit or perseverance)")
+	marsCmd.PersistentFlags().IntP("sol", "", 0, "Martian sol of the rover's mission")
+	marsCmd.PersistentFlags().StringP("camera", "", "", "camera abbreviation (e.g. FHAZ, RHAZ, NAVCAM)")
+	marsCmd.PersistentFlags().IntP("page", "", 0, "page number of photos (25 photos per page, 0 is all photos)")
+
+	marsManifestCmd := &cobra.Command{
+		Use:   "manifest",
+		Short: "Look up mission manifest of Mars rover",
+		Long:  "Look up mission manifest of Mars rover.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cfg, err := makeMarsConfig(ui, cmd)
+			if err != nil {
+				return debugPrint(ui, err)
+			}
+			manifest, err := cfg.Manifest(cmd.Context())
+			if err != nil {
+				return debugPrint(ui, err)
+			}
+			return debugPrint(ui, outputJSON(ui, cfg.Client(), manifest))
+		},
+	}
+
+	marsDownloadCmd := &cobra.Command{
+		Use:   "download",
+		Short: "Download Mars rover photos",
+		Long:  "Download Mars rover photos into <base dir>/<earth date>/<photo id>/ directory with metadata.json file.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cfg, err := makeMarsConfig(ui, cmd)
+			if err != nil {
+				return debugPrint(ui, err)
+			}
+			// local options
+			dir, err := cmd.Flags().GetString("base-dir")
+			if err != nil {
+				return debugPrint(ui, err)
+			}
+			overwriteFlag, err := cmd.Flags().GetBool("overwrite")
+			if err != nil {
+				return debugPrint(ui, err)
+			}
+			maxSize, err := getMaxSize(cmd)
+			if err != nil {
+				return debugPrint(ui, err)
+			}
+
+			// download photos
+			if err := download.NewMarsRover(cfg, dir, overwriteFlag, download.WithFileMaxSize(maxSize)).Do(cmd.Context()); err != nil {
+				return debugPrint(ui, err)
+			}
+			warnRateLimit(ui, cfg.Client())
+			return nil
+		},
+	}
+	marsDownloadCmd.Flags().StringP("base-dir", "d", "./mars", "Base directory for download")
+	marsDownloadCmd.Flags().BoolP("overwrite", "", false, "Overwrite Download files")
+	marsDownloadCmd.Flags().StringP("max-size", "", "1GB", "maximum size of a downloaded file (e.g. 500MB, 2GB)")
+
+	marsCmd.AddCommand(marsManifestCmd, marsDownloadCmd)
+	return marsCmd
+}
+
+// makeMarsConfig returns marsrover.Request instance from options.
+func makeMarsConfig(ui *rwi.RWI, cmd *cobra.Command) (*marsrover.Request, error) {
+	cli, err := makeClient(ui)
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	roverStr, err := cmd.Flags().GetString("rover")
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	rover, err := marsrover.RoverFrom(roverStr)
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	camera, err := cmd.Flags().GetString("camera")
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	page, err := cmd.Flags().GetInt("page")
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	date, _, err := parseDateWindow()
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	opts := []marsrover.Opts{
+		marsrover.WithRover(rover),
+		marsrover.WithEarthDate(date),
+		marsrover.WithCamera(camera),
+		marsrover.WithPage(page),
+		marsrover.WithAPIKey(viper.GetString("api-key")),
+		marsrover.WithClient(cli),
+	}
+	if cmd.Flags().Changed("sol") {
+		sol, err := cmd.Flags().GetInt("sol")
+		if err != nil {
+			return nil, errs.Wrap(err)
+		}
+		opts = append(opts, marsrover.WithSol(sol))
+	}
+	return marsrover.New(opts...), nil
+}
+
+/* MIT License
+ *
+ * Copyright 2023 Spiegel
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
@@ -0,0 +1,162 @@
+package facade
+
+import (
+	"github.com/goark/apod/nasaapi"
+	"github.com/goark/apod/nasaapi/neows"
+	"github.com/goark/apod/service/neo"
+	"github.com/goark/errs"
+	"github.com/goark/gocli/rwi"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+// newNeo returns cobra.Command instance for neo sub-command
+func newNeo(ui *rwi.RWI) *cobra.Command {
+	neoCmd := &cobra.Command{
+		Use:   "neo",
+		Short: "List close approaches of near earth objects",
+		Long:  "List close approaches of near earth objects (asteroids) in date window with NeoWs API.\nDate window is given by --date, --start-date and --end-date (default is 7 days from today).",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// global options
+			cfg, err := makeNeoConfig(ui)
+			if err != nil {
+				return debugPrint(ui, err)
+			}
+			// local options
+			rawFlag, err := cmd.Flags().GetBool("raw")
+			if err != nil {
+				return debugPrint(ui, err)
+			}
+
+			// list close approaches
+			r, err := neo.New(cfg, rawFlag).Do(cmd.Context())
+			if err != nil {
+				return debugPrint(ui, err)
+			}
+			defer r.Close()
+			if err := ui.WriteFrom(r); err != nil {
+				return debugPrint(ui, err)
+			}
+			warnRateLimit(ui, cfg.Client())
+			return nil
+		},
+	}
+	neoCmd.Flags().BoolP("raw", "", false, "Output raw data from NeoWs API (date window is limited to 7 days)")
+
+	neoLookupCmd := &cobra.Command{
+		Use:   "lookup <SPK-ID>",
+		Short: "Look up a near earth object",
+		Long:  "Look up a near earth object by NASA JPL small body ID (SPK-ID).",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cfg, err := makeNeoConfig(ui)
+			if err != nil {
+				return debugPrint(ui, err)
+			}
+			obj, err := cfg.Lookup(cmd.Context(), args[0])
+			if err != nil {
+				return debugPrint(ui, err)
+			}
+			return debugPrint(ui, outputJSON(ui, cfg.Client(), obj))
+		},
+	}
+
+	neoBrowseCmd := &cobra.Command{
+		Use:   "browse",
+		Short: "Browse the overall near earth object data-set",
+		Long:  "Browse the overall near earth object data-set.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cfg, err := makeNeoConfig(ui)
+			if err != nil {
+				return debugPrint(ui, err)
+			}
+			// local options
+			page, err := cmd.Flags().GetInt("page")
+			if err != nil {
+				return debugPrint(ui, err)
+			}
+			size, err := cmd.Flags().GetInt("size")
+			if err != nil {
+				return debugPrint(ui, err)
+			}
+			pages, err := cmd.Flags().GetInt("pages")
+			if err != nil {
+				return debugPrint(ui, err)
+			}
+			neows.WithPage(page)(cfg)
+			neows.WithSize(size)(cfg)
+
+			objs := []*neows.NearEarthObject{}
+			if err := cfg.BrowseAll(cmd.Context(), func(p *neows.BrowsePage) bool {
+				objs = append(objs, p.NearEarthObjects...)
+				pages--
+				return pages != 0
+			}); err != nil {
+				return debugPrint(ui, err)
+			}
+			return debugPrint(ui, outputJSON(ui, cfg.Client(), objs))
+		},
+	}
+	neoBrowseCmd.Flags().IntP("page", "", 0, "first page number (0 origin)")
+	neoBrowseCmd.Flags().IntP("size", "", 20, "number of objects per page")
+	neoBrowseCmd.Flags().IntP("pages", "", 1, "number of pages to browse (0 is all pages)")
+
+	neoCmd.AddCommand(neoLookupCmd, neoBrowseCmd)
+	return neoCmd
+}
+
+func makeNeoConfig(ui *rwi.RWI) (*neows.Request, error) {
+	cli, err := makeClient(ui)
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	startDate, endDate, err := makeDateWindow(neows.MaxFeedDays)
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return neows.New(
+		neows.WithStartDate(startDate),
+		neows.WithEndDate(endDate),
+		neows.WithAPIKey(viper.GetString("api-key")),
+		neows.WithClient(cli),
+	), nil
+}
+
+// makeDateWindow returns date window from --date, --start-date and --end-date flags.
+// If window is not set, it starts today. If end of window is not set, it is days after start.
+func makeDateWindow(days int) (nasaapi.Date, nasaapi.Date, error) {
+	startDate, endDate, err := parseDateWindow()
+	if err != nil {
+		return nasaapi.Date{}, nasaapi.Date{}, errs.Wrap(err)
+	}
+	if startDate.IsZero() {
+		startDate = nasaapi.Today()
+	}
+	if endDate.IsZero() {
+		endDate = nasaapi.NewDate(startDate.AddDate(0, 0, days))
+	}
+	return startDate, endDate, nil
+}
+
+/* MIT License
+ *
+ * Copyright 2023 Spiegel
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
@@ -0,0 +1,45 @@
+package facade
+
+import (
+	"encoding/json"
+
+	"github.com/goark/apod/nasaapi"
+	"github.com/goark/errs"
+	"github.com/goark/gocli/rwi"
+)
+
+// outputJSON outputs v as JSON data, and warns if remaining quota of NASA API key drops below threshold.
+func outputJSON(ui *rwi.RWI, cli *nasaapi.Client, v interface{}) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return errs.Wrap(err)
+	}
+	if err := ui.Outputln(string(b)); err != nil {
+		return errs.Wrap(err)
+	}
+	warnRateLimit(ui, cli)
+	return nil
+}
+
+/* MIT License
+ *
+ * Copyright 2023 Spiegel
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
@@ -0,0 +1,76 @@
+package facade
+
+import (
+	"encoding/json"
+
+	"github.com/goark/apod/nasaapi"
+	"github.com/goark/apod/service/quota"
+	"github.com/goark/errs"
+	"github.com/goark/gocli/rwi"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+// newQuota returns cobra.Command instance for quota sub-command
+func newQuota(ui *rwi.RWI) *cobra.Command {
+	quotaCmd := &cobra.Command{
+		Use:     "quota",
+		Aliases: []string{"q"},
+		Short:   "Report remaining quota of NASA API key",
+		Long:    "Report remaining quota of NASA API key (this command consumes one request).",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// global options
+			cfg, err := makeAPODConfig(ui)
+			if err != nil {
+				return debugPrint(ui, err)
+			}
+
+			// get rate limit information
+			rl, err := quota.New(cfg).Do(cmd.Context())
+			if err != nil {
+				return debugPrint(ui, err)
+			}
+			b, err := json.Marshal(rl)
+			if err != nil {
+				return debugPrint(ui, errs.Wrap(err))
+			}
+			return debugPrint(ui, ui.Outputln(string(b)))
+		},
+	}
+
+	return quotaCmd
+}
+
+// warnRateLimit outputs warning message if remaining quota of NASA API key drops below threshold.
+func warnRateLimit(ui *rwi.RWI, cli *nasaapi.Client) {
+	threshold := viper.GetInt("quota-warning")
+	if threshold <= 0 {
+		return
+	}
+	if rl, ok := cli.RateLimit(); ok && rl.Remaining < threshold {
+		_ = ui.OutputErrln("Warning: NASA API quota is running low:", rl.String())
+	}
+}
+
+/* MIT License
+ *
+ * Copyright 2023 Spiegel
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
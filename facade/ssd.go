@@ -0,0 +1,276 @@
+package facade
+
+import (
+	"net/url"
+
+	"github.com/goark/apod/ecode"
+	"github.com/goark/apod/nasaapi"
+	"github.com/goark/apod/nasaapi/ssd"
+	"github.com/goark/apod/nasaapi/ssd/cad"
+	"github.com/goark/apod/nasaapi/ssd/fireball"
+	"github.com/goark/apod/nasaapi/ssd/sentry"
+	sssd "github.com/goark/apod/service/ssd"
+	"github.com/goark/errs"
+	"github.com/goark/gocli/rwi"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+// newSSD returns cobra.Command instance for ssd sub-command
+func newSSD(ui *rwi.RWI) *cobra.Command {
+	ssdCmd := &cobra.Command{
+		Use:   "ssd",
+		Short: "Query JPL SSD/CNEOS APIs",
+		Long:  "Query JPL SSD/CNEOS (Solar System Dynamics / Center for Near Earth Object Studies) APIs: close approaches, fireballs and Sentry impact risks.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return debugPrint(ui, errs.Wrap(ecode.ErrNoCommand))
+		},
+	}
+	ssdCmd.PersistentFlags().StringP("ssd-base-url", "", ssd.DefaultBaseURL, "base URL of JPL SSD/CNEOS API")
+	ssdCmd.PersistentFlags().StringP("format", "", "json", "output format (json, table or csv)")
+	_ = viper.BindPFlag("ssd-base-url", ssdCmd.PersistentFlags().Lookup("ssd-base-url"))
+
+	ssdCadCmd := &cobra.Command{
+		Use:   "cad",
+		Short: "List close approaches of asteroids and comets",
+		Long:  "List close approaches of asteroids and comets to planets (cad.api).\nDate and distance limits accept the expressions of the API (e.g. now, +60, 0.05, 10LD).",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cli, baseURL, format, err := makeSSDConfig(ui, cmd)
+			if err != nil {
+				return debugPrint(ui, err)
+			}
+			strs, err := getStringFlags(cmd, "date-min", "date-max", "dist-min", "dist-max", "h-min", "h-max", "body", "des", "sort")
+			if err != nil {
+				return debugPrint(ui, err)
+			}
+			bools, err := getBoolFlags(cmd, "pha", "nea", "fullname", "diameter")
+			if err != nil {
+				return debugPrint(ui, err)
+			}
+			limit, err := cmd.Flags().GetInt("limit")
+			if err != nil {
+				return debugPrint(ui, err)
+			}
+			req := cad.New(
+				cad.WithDateRange(strs["date-min"], strs["date-max"]),
+				cad.WithDistRange(strs["dist-min"], strs["dist-max"]),
+				cad.WithHRange(strs["h-min"], strs["h-max"]),
+				cad.WithBody(strs["body"]),
+				cad.WithDes(strs["des"]),
+				cad.WithSort(strs["sort"]),
+				cad.WithPHA(bools["pha"]),
+				cad.WithNEA(bools["nea"]),
+				cad.WithFullname(bools["fullname"]),
+				cad.WithDiameter(bools["diameter"]),
+				cad.WithLimit(limit),
+				cad.WithBaseURL(baseURL),
+				cad.WithClient(cli),
+			)
+			list, err := req.Get(cmd.Context())
+			if err != nil {
+				return debugPrint(ui, err)
+			}
+			return debugPrint(ui, outputSSD(ui, req.Client(), list, format))
+		},
+	}
+	ssdCadCmd.Flags().StringP("date-min", "", "", "exclude data earlier than this date (default now)")
+	ssdCadCmd.Flags().StringP("date-max", "", "", "exclude data later than this date (default +60)")
+	ssdCadCmd.Flags().StringP("dist-min", "", "", "exclude data with approach distance less than this (au or LD)")
+	ssdCadCmd.Flags().StringP("dist-max", "", "", "exclude data with approach distance greater than this (default 0.05 au)")
+	ssdCadCmd.Flags().StringP("h-min", "", "", "exclude data from objects with H-values less than this")
+	ssdCadCmd.Flags().StringP("h-max", "", "", "exclude data from objects with H-values greater than this")
+	ssdCadCmd.Flags().StringP("body", "", "", "close approach body (e.g. Earth, Mars, ALL; default Earth)")
+	ssdCadCmd.Flags().StringP("des", "", "", "only data for the object matching this designation")
+	ssdCadCmd.Flags().StringP("sort", "", "", "sort data on the specified field (e.g. date, dist, -h)")
+	ssdCadCmd.Flags().BoolP("pha", "", false, "limit data to PHAs")
+	ssdCadCmd.Flags().BoolP("nea", "", false, "limit data to NEAs")
+	ssdCadCmd.Flags().BoolP("fullname", "", false, "include full-format object name")
+	ssdCadCmd.Flags().BoolP("diameter", "", false, "include known diameter")
+	ssdCadCmd.Flags().IntP("limit", "", 0, "maximum number of records")
+
+	ssdFireballCmd := &cobra.Command{
+		Use:   "fireball",
+		Short: "List fireball events",
+		Long:  "List fireball (bolide) events reported by US Government sensors (fireball.api).",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cli, baseURL, format, err := makeSSDConfig(ui, cmd)
+			if err != nil {
+				return debugPrint(ui, err)
+			}
+			strs, err := getStringFlags(cmd, "date-min", "date-max", "energy-min", "energy-max", "impact-e-min", "impact-e-max", "sort")
+			if err != nil {
+				return debugPrint(ui, err)
+			}
+			bools, err := getBoolFlags(cmd, "req-loc", "req-alt", "req-vel", "vel-comp")
+			if err != nil {
+				return debugPrint(ui, err)
+			}
+			limit, err := cmd.Flags().GetInt("limit")
+			if err != nil {
+				return debugPrint(ui, err)
+			}
+			req := fireball.New(
+				fireball.WithDateRange(strs["date-min"], strs["date-max"]),
+				fireball.WithEnergyRange(strs["energy-min"], strs["energy-max"]),
+				fireball.WithImpactERange(strs["impact-e-min"], strs["impact-e-max"]),
+				fireball.WithSort(strs["sort"]),
+				fireball.WithRequired(bools["req-loc"], bools["req-alt"], bools["req-vel"]),
+				fireball.WithVelComp(bools["vel-comp"]),
+				fireball.WithLimit(limit),
+				fireball.WithBaseURL(baseURL),
+				fireball.WithClient(cli),
+			)
+			list, err := req.Get(cmd.Context())
+			if err != nil {
+				return debugPrint(ui, err)
+			}
+			return debugPrint(ui, outputSSD(ui, req.Client(), list, format))
+		},
+	}
+	ssdFireballCmd.Flags().StringP("date-min", "", "", "exclude data earlier than this date (YYYY-MM-DD)")
+	ssdFireballCmd.Flags().StringP("date-max", "", "", "exclude data later than this date (YYYY-MM-DD)")
+	ssdFireballCmd.Flags().StringP("energy-min", "", "", "exclude data with total radiated energy less than this (10^10 J)")
+	ssdFireballCmd.Flags().StringP("energy-max", "", "", "exclude data with total radiated energy greater than this (10^10 J)")
+	ssdFireballCmd.Flags().StringP("impact-e-min", "", "", "exclude data with estimated impact energy less than this (kt)")
+	ssdFireballCmd.Flags().StringP("impact-e-max", "", "", "exclude data with estimated impact energy greater than this (kt)")
+	ssdFireballCmd.Flags().StringP("sort", "", "", "sort data on the specified field (e.g. date, -energy)")
+	ssdFireballCmd.Flags().BoolP("req-loc", "", false, "only data with location")
+	ssdFireballCmd.Flags().BoolP("req-alt", "", false, "only data with altitude")
+	ssdFireballCmd.Flags().BoolP("req-vel", "", false, "only data with velocity")
+	ssdFireballCmd.Flags().BoolP("vel-comp", "", false, "include velocity components")
+	ssdFireballCmd.Flags().IntP("limit", "", 0, "maximum number of records")
+
+	ssdSentryCmd := &cobra.Command{
+		Use:   "sentry [designation]",
+		Short: "List objects in Sentry impact risk table",
+		Long:  "List objects in Sentry impact risk table (sentry.api).\nIf designation is given, output summary and virtual impactors of the object (table and csv formats output virtual impactors only).",
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cli, baseURL, format, err := makeSSDConfig(ui, cmd)
+			if err != nil {
+				return debugPrint(ui, err)
+			}
+			strs, err := getStringFlags(cmd, "h-max", "ps-min", "ip-min")
+			if err != nil {
+				return debugPrint(ui, err)
+			}
+			days, err := cmd.Flags().GetInt("days")
+			if err != nil {
+				return debugPrint(ui, err)
+			}
+			req := sentry.New(
+				sentry.WithHMax(strs["h-max"]),
+				sentry.WithPSMin(strs["ps-min"]),
+				sentry.WithIPMin(strs["ip-min"]),
+				sentry.WithDays(days),
+				sentry.WithBaseURL(baseURL),
+				sentry.WithClient(cli),
+			)
+			if len(args) > 0 {
+				detail, err := req.Object(cmd.Context(), args[0])
+				if err != nil {
+					return debugPrint(ui, err)
+				}
+				if format == sssd.FormatJSON {
+					return debugPrint(ui, outputJSON(ui, req.Client(), detail))
+				}
+				return debugPrint(ui, outputSSD(ui, req.Client(), detail.Data, format))
+			}
+			list, err := req.Objects(cmd.Context())
+			if err != nil {
+				return debugPrint(ui, err)
+			}
+			return debugPrint(ui, outputSSD(ui, req.Client(), list, format))
+		},
+	}
+	ssdSentryCmd.Flags().StringP("h-max", "", "", "exclude objects with H greater than this")
+	ssdSentryCmd.Flags().StringP("ps-min", "", "", "exclude objects with Palermo scale less than this")
+	ssdSentryCmd.Flags().StringP("ip-min", "", "", "exclude objects with impact probability less than this")
+	ssdSentryCmd.Flags().IntP("days", "", 0, "exclude objects not observed within this number of days")
+
+	ssdCmd.AddCommand(ssdCadCmd, ssdFireballCmd, ssdSentryCmd)
+	return ssdCmd
+}
+
+// makeSSDConfig returns nasaapi.Client instance, base URL and output format from global and persistent options.
+func makeSSDConfig(ui *rwi.RWI, cmd *cobra.Command) (*nasaapi.Client, *url.URL, sssd.Format, error) {
+	cli, err := makeClient(ui)
+	if err != nil {
+		return nil, nil, sssd.FormatJSON, errs.Wrap(err)
+	}
+	baseURL, err := url.Parse(viper.GetString("ssd-base-url"))
+	if err != nil {
+		return nil, nil, sssd.FormatJSON, errs.Wrap(err, errs.WithContext("ssd-base-url", viper.GetString("ssd-base-url")))
+	}
+	formatStr, err := cmd.Flags().GetString("format")
+	if err != nil {
+		return nil, nil, sssd.FormatJSON, errs.Wrap(err)
+	}
+	format, err := sssd.FormatFrom(formatStr)
+	if err != nil {
+		return nil, nil, sssd.FormatJSON, errs.Wrap(err)
+	}
+	return cli, baseURL, format, nil
+}
+
+// getStringFlags returns values of string flags by name.
+func getStringFlags(cmd *cobra.Command, names ...string) (map[string]string, error) {
+	values := make(map[string]string, len(names))
+	for _, name := range names {
+		v, err := cmd.Flags().GetString(name)
+		if err != nil {
+			return nil, errs.Wrap(err, errs.WithContext("flag", name))
+		}
+		values[name] = v
+	}
+	return values, nil
+}
+
+// getBoolFlags returns values of bool flags by name.
+func getBoolFlags(cmd *cobra.Command, names ...string) (map[string]bool, error) {
+	values := make(map[string]bool, len(names))
+	for _, name := range names {
+		v, err := cmd.Flags().GetBool(name)
+		if err != nil {
+			return nil, errs.Wrap(err, errs.WithContext("flag", name))
+		}
+		values[name] = v
+	}
+	return values, nil
+}
+
+// outputSSD outputs list of records in the format, and warns if remaining quota of NASA API key drops below threshold.
+func outputSSD(ui *rwi.RWI, cli *nasaapi.Client, list interface{}, format sssd.Format) error {
+	r, err := sssd.Encode(list, format)
+	if err != nil {
+		return errs.Wrap(err)
+	}
+	if err := ui.WriteFrom(r); err != nil {
+		return errs.Wrap(err)
+	}
+	warnRateLimit(ui, cli)
+	return nil
+}
+
+/* MIT License
+ *
+ * Copyright 2023 Spiegel
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
@@ -0,0 +1,108 @@
+package facade
+
+import (
+	"strconv"
+
+	"github.com/goark/apod/ecode"
+	"github.com/goark/apod/nasaapi"
+	"github.com/goark/apod/nasaapi/techport"
+	"github.com/goark/errs"
+	"github.com/goark/gocli/rwi"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+// newTechPort returns cobra.Command instance for techport sub-command
+func newTechPort(ui *rwi.RWI) *cobra.Command {
+	techportCmd := &cobra.Command{
+		Use:   "techport",
+		Short: "List NASA technology projects from TechPort",
+		Long:  "List NASA technology projects from TechPort.\nIf --updated-since is given, lists projects updated since the date.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cfg, err := makeTechPortConfig(ui)
+			if err != nil {
+				return debugPrint(ui, err)
+			}
+			// local options
+			updatedSinceStr, err := cmd.Flags().GetString("updated-since")
+			if err != nil {
+				return debugPrint(ui, err)
+			}
+			if len(updatedSinceStr) > 0 {
+				updatedSince, err := nasaapi.ParseDate(updatedSinceStr)
+				if err != nil {
+					return debugPrint(ui, err)
+				}
+				techport.WithUpdatedSince(updatedSince)(cfg)
+			}
+
+			// list projects
+			projects, err := cfg.Projects(cmd.Context())
+			if err != nil {
+				return debugPrint(ui, err)
+			}
+			return debugPrint(ui, outputJSON(ui, cfg.Client(), projects))
+		},
+	}
+	techportCmd.Flags().StringP("updated-since", "", "", "list projects updated since the date (same expressions as --date)")
+
+	techportProjectCmd := &cobra.Command{
+		Use:   "project <project ID>",
+		Short: "Look up details of TechPort project",
+		Long:  "Look up details of TechPort project.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cfg, err := makeTechPortConfig(ui)
+			if err != nil {
+				return debugPrint(ui, err)
+			}
+			id, err := strconv.Atoi(args[0])
+			if err != nil {
+				return debugPrint(ui, errs.Wrap(ecode.ErrInvalidParameter, errs.WithCause(err), errs.WithContext("id", args[0])))
+			}
+			project, err := cfg.Project(cmd.Context(), id)
+			if err != nil {
+				return debugPrint(ui, err)
+			}
+			return debugPrint(ui, outputJSON(ui, cfg.Client(), project))
+		},
+	}
+
+	techportCmd.AddCommand(techportProjectCmd)
+	return techportCmd
+}
+
+// makeTechPortConfig returns techport.Request instance from global options.
+func makeTechPortConfig(ui *rwi.RWI) (*techport.Request, error) {
+	cli, err := makeClient(ui)
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return techport.New(
+		techport.WithAPIKey(viper.GetString("api-key")),
+		techport.WithClient(cli),
+	), nil
+}
+
+/* MIT License
+ *
+ * Copyright 2023 Spiegel
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
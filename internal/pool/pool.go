@@ -0,0 +1,63 @@
+package pool
+
+import (
+	"context"
+	"sync"
+
+	"github.com/goark/errs"
+)
+
+// Run function calls fn function for each index in [0, count) with at most n goroutines (less than 1 is 1),
+// and returns errors in index order (nil for succeeded jobs).
+// Jobs not started before ctx is done are not called, and their errors are ctx.Err().
+func Run(ctx context.Context, n, count int, fn func(ctx context.Context, i int) error) []error {
+	if n < 1 {
+		n = 1
+	}
+	errList := make([]error, count)
+	sem := make(chan struct{}, n)
+	var wg sync.WaitGroup
+	for i := 0; i < count; i++ {
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+			errList[i] = errs.Wrap(ctx.Err())
+			continue
+		}
+		if err := ctx.Err(); err != nil {
+			<-sem
+			errList[i] = errs.Wrap(err)
+			continue
+		}
+		wg.Add(1)
+		go func(i int) {
+			defer func() { <-sem; wg.Done() }()
+			errList[i] = fn(ctx, i)
+		}(i)
+	}
+	wg.Wait()
+	return errList
+}
+
+/* MIT License
+ *
+ * Copyright 2023 Spiegel
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
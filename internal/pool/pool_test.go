@@ -0,0 +1,79 @@
+package pool
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestRun(t *testing.T) {
+	testCases := []struct {
+		n     int
+		count int
+	}{
+		{n: 0, count: 5},
+		{n: 1, count: 5},
+		{n: 3, count: 10},
+		{n: 10, count: 3},
+	}
+
+	for _, tc := range testCases {
+		var running, maxRunning int32
+		errList := Run(context.Background(), tc.n, tc.count, func(ctx context.Context, i int) error {
+			r := atomic.AddInt32(&running, 1)
+			defer atomic.AddInt32(&running, -1)
+			for {
+				m := atomic.LoadInt32(&maxRunning)
+				if r <= m || atomic.CompareAndSwapInt32(&maxRunning, m, r) {
+					break
+				}
+			}
+			time.Sleep(time.Millisecond)
+			if i%2 == 1 {
+				return errors.New("odd")
+			}
+			return nil
+		})
+		if len(errList) != tc.count {
+			t.Errorf("Run(%v, %v) returns %v errors, want %v", tc.n, tc.count, len(errList), tc.count)
+			continue
+		}
+		for i, err := range errList {
+			if (err != nil) != (i%2 == 1) {
+				t.Errorf("Run(%v, %v)[%v] is \"%v\"", tc.n, tc.count, i, err)
+			}
+		}
+		limit := int32(tc.n)
+		if limit < 1 {
+			limit = 1
+		}
+		if maxRunning > limit {
+			t.Errorf("Run(%v, %v) runs %v jobs concurrently, want <= %v", tc.n, tc.count, maxRunning, limit)
+		}
+	}
+}
+
+/* MIT License
+ *
+ * Copyright 2023 Spiegel
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
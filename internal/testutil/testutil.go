@@ -0,0 +1,58 @@
+// Package testutil provides helpers shared by tests of NASA API packages.
+package testutil
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/goark/apod/nasaapi"
+)
+
+// NewServer function starts httptest.Server with handler, and returns its base URL and nasaapi.Client connecting to the server.
+// The client has no API key limiter, and additional opts are applied after the defaults.
+// The server is closed when the test finishes.
+func NewServer(t testing.TB, handler http.HandlerFunc, opts ...nasaapi.ClientOpts) (*url.URL, *nasaapi.Client) {
+	t.Helper()
+	ts := httptest.NewServer(handler)
+	t.Cleanup(ts.Close)
+	u, err := url.Parse(ts.URL)
+	if err != nil {
+		t.Fatalf("url.Parse(%v) is \"%v\", want nil", ts.URL, err)
+	}
+	return u, nasaapi.NewClient(append([]nasaapi.ClientOpts{nasaapi.WithBaseURL(u), nasaapi.WithKeyLimiter(nil)}, opts...)...)
+}
+
+// DateFromMust function returns nasaapi.Date from string s, and fails the test if s is invalid.
+func DateFromMust(t testing.TB, s string) nasaapi.Date {
+	t.Helper()
+	date, err := nasaapi.DateFrom(s)
+	if err != nil {
+		t.Fatalf("nasaapi.DateFrom(%v) is \"%v\", want nil", s, err)
+	}
+	return date
+}
+
+/* MIT License
+ *
+ * Copyright 2023 Spiegel
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
@@ -2,6 +2,7 @@ package main
 
 import (
 	"os"
+	_ "time/tzdata" // for APOD publishing time zone (US Eastern)
 
 	"github.com/goark/apod/facade"
 	"github.com/goark/gocli/rwi"
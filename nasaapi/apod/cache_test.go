@@ -0,0 +1,73 @@
+package apod
+
+import (
+	"context"
+	"net/http"
+	"sync/atomic"
+	"testing"
+
+	"github.com/goark/apod/internal/testutil"
+	"github.com/goark/apod/nasaapi/cache"
+)
+
+func TestGetWithCache(t *testing.T) {
+	var count int32
+	_, cli := testutil.NewServer(t, func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&count, 1)
+		if r.URL.Query().Has("count") {
+			_, _ = w.Write([]byte(`[{"date":"2023-02-22","title":"foo"}]`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"date":"2023-02-22","title":"foo"}`))
+	})
+	c := cache.New(t.TempDir())
+
+	testCases := []struct {
+		req   *Request
+		count int32
+	}{
+		{req: New(WithDate(dateFromMust("2023-02-22")), WithAPIKey("foo"), WithClient(cli), WithCache(c)), count: 1},
+		{req: New(WithDate(dateFromMust("2023-02-22")), WithAPIKey("bar"), WithClient(cli), WithCache(c)), count: 1}, // api_key is not part of cache key
+		{req: New(WithDate(dateFromMust("2023-02-22")), WithThumbs(true), WithClient(cli), WithCache(c)), count: 2},
+		{req: New(WithCount(1), WithClient(cli), WithCache(c)), count: 3},
+		{req: New(WithCount(1), WithClient(cli), WithCache(c)), count: 4}, // count is never cached
+		{req: New(WithDate(dateFromMust("2023-02-22")), WithClient(cli)), count: 5},
+	}
+
+	for _, tc := range testCases {
+		resps, err := tc.req.Get(context.Background())
+		if err != nil {
+			t.Errorf("Get() is \"%v\", want nil", err)
+			continue
+		}
+		if len(resps) != 1 || resps[0].Title != "foo" {
+			t.Errorf("Get() is %v, want 1 response", resps)
+		}
+		if count != tc.count {
+			t.Errorf("number of requests is %v, want %v", count, tc.count)
+		}
+	}
+}
+
+/* MIT License
+ *
+ * Copyright 2023 Spiegel
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
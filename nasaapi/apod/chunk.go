@@ -0,0 +1,199 @@
+package apod
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/goark/apod/internal/pool"
+	"github.com/goark/apod/nasaapi"
+	"github.com/goark/errs"
+)
+
+const DefaultChunkDays = 30 // Default number of days in a chunk of date range
+
+// WithChunkDays returns function for setting number of days in a chunk of date range.
+// If days is zero, DefaultChunkDays is used. If days is negative, date range is not split.
+func WithChunkDays(days int) Opts {
+	return func(ctx *Request) {
+		if ctx != nil {
+			ctx.chunkDays = days
+		}
+	}
+}
+
+// WithConcurrency returns function for setting maximum number of chunks fetched concurrently.
+func WithConcurrency(n int) Opts {
+	return func(ctx *Request) {
+		if ctx != nil {
+			ctx.concurrency = n
+		}
+	}
+}
+
+// ChunkFailure is failure information of a chunk of date range.
+type ChunkFailure struct {
+	StartDate nasaapi.Date `json:"start_date"`
+	EndDate   nasaapi.Date `json:"end_date"`
+	Err       error        `json:"-"`
+}
+
+// ChunkError is error for partial failure of request split into chunks.
+type ChunkError struct {
+	Chunks   int            // number of all chunks
+	Failures []ChunkFailure // failed chunks in date order
+}
+
+// Error method returns error message. (implementation of error interface)
+func (e *ChunkError) Error() string {
+	if e == nil {
+		return "<nil>"
+	}
+	msgs := make([]string, 0, len(e.Failures))
+	for _, f := range e.Failures {
+		end := f.EndDate.String()
+		if len(end) == 0 {
+			end = "today"
+		}
+		msgs = append(msgs, fmt.Sprintf("%v..%v: %v", f.StartDate, end, f.Err))
+	}
+	return fmt.Sprintf("failed to get %d of %d date ranges (%s)", len(e.Failures), e.Chunks, strings.Join(msgs, "; "))
+}
+
+// Unwrap method returns errors of failed chunks. (used in errors.Is and errors.As functions)
+func (e *ChunkError) Unwrap() []error {
+	if e == nil {
+		return nil
+	}
+	errList := make([]error, 0, len(e.Failures))
+	for _, f := range e.Failures {
+		errList = append(errList, f.Err)
+	}
+	return errList
+}
+
+// chunks method splits date range of Request. If the range is not split, returns nil.
+func (apod *Request) chunks() []*Request {
+	days := apod.chunkDays
+	if days == 0 {
+		days = DefaultChunkDays
+	}
+	if days < 0 || apod.StartDate.IsZero() || !apod.Date.IsZero() || apod.Count > 0 {
+		return nil
+	}
+	end := apod.EndDate
+	if end.IsZero() {
+		end = nasaapi.Today()
+	}
+	if end.Before(apod.StartDate.AddDate(0, 0, days)) {
+		return nil
+	}
+	var reqs []*Request
+	for start := apod.StartDate; !start.After(end.Time); start = nasaapi.NewDate(start.AddDate(0, 0, days)) {
+		req := *apod
+		req.StartDate = start
+		req.EndDate = nasaapi.NewDate(start.AddDate(0, 0, days-1))
+		if !req.EndDate.Before(end.Time) {
+			req.EndDate = apod.EndDate // keep original end date (may be zero: today)
+		}
+		req.chunkDays = -1
+		reqs = append(reqs, &req)
+	}
+	return reqs
+}
+
+// fetchChunks method calls fn function for each chunk with bounded concurrency.
+func (apod *Request) fetchChunks(ctx context.Context, reqs []*Request, fn func(ctx context.Context, i int, req *Request) error) error {
+	errList := pool.Run(ctx, apod.concurrency, len(reqs), func(ctx context.Context, i int) error {
+		return fn(ctx, i, reqs[i])
+	})
+	chunkErr := &ChunkError{Chunks: len(reqs)}
+	for i, err := range errList {
+		if err != nil {
+			chunkErr.Failures = append(chunkErr.Failures, ChunkFailure{StartDate: reqs[i].StartDate, EndDate: reqs[i].EndDate, Err: err})
+		}
+	}
+	if len(chunkErr.Failures) > 0 {
+		return errs.Wrap(chunkErr)
+	}
+	return nil
+}
+
+// getChunks method gets APOD data for each chunk, and merges results in date order.
+// If some chunks fail, returns merged results of succeeded chunks with ChunkError.
+func (apod *Request) getChunks(ctx context.Context, reqs []*Request) ([]*Response, nasaapi.RateLimit, error) {
+	results := make([][]*Response, len(reqs))
+	var mutex sync.Mutex
+	var rl nasaapi.RateLimit
+	err := apod.fetchChunks(ctx, reqs, func(ctx context.Context, i int, req *Request) error {
+		resps, r, err := req.GetWithRateLimit(ctx)
+		if err != nil {
+			return err
+		}
+		results[i] = resps
+		if r.Limit > 0 {
+			mutex.Lock()
+			rl = r
+			mutex.Unlock()
+		}
+		return nil
+	})
+	var merged []*Response
+	for _, resps := range results {
+		merged = append(merged, resps...)
+	}
+	sort.SliceStable(merged, func(i, j int) bool { return merged[i].Date.Before(merged[j].Date.Time) })
+	return merged, rl, err
+}
+
+// getRawChunks method gets raw APOD data for each chunk, and merges them into a JSON array.
+func (apod *Request) getRawChunks(ctx context.Context, reqs []*Request) (io.ReadCloser, error) {
+	results := make([][]json.RawMessage, len(reqs))
+	err := apod.fetchChunks(ctx, reqs, func(ctx context.Context, i int, req *Request) error {
+		r, err := req.GetRawData(ctx)
+		if err != nil {
+			return err
+		}
+		defer r.Close()
+		return errs.Wrap(json.NewDecoder(r).Decode(&results[i]))
+	})
+	if err != nil {
+		return nil, err
+	}
+	merged := []json.RawMessage{}
+	for _, raws := range results {
+		merged = append(merged, raws...)
+	}
+	b, err := json.Marshal(merged)
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return io.NopCloser(strings.NewReader(string(b))), nil
+}
+
+/* MIT License
+ *
+ * Copyright 2023 Spiegel
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
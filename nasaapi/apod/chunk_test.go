@@ -0,0 +1,124 @@
+package apod
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/goark/apod/ecode"
+	"github.com/goark/apod/internal/testutil"
+	"github.com/goark/apod/nasaapi"
+)
+
+func TestChunks(t *testing.T) {
+	testCases := []struct {
+		start     string
+		end       string
+		chunkDays int
+		want      [][2]string
+	}{
+		{start: "2023-01-01", end: "2023-01-30", chunkDays: 30, want: nil},
+		{start: "2023-01-01", end: "2023-01-31", chunkDays: 30, want: [][2]string{{"2023-01-01", "2023-01-30"}, {"2023-01-31", "2023-01-31"}}},
+		{start: "2023-01-01", end: "2023-03-15", chunkDays: 30, want: [][2]string{{"2023-01-01", "2023-01-30"}, {"2023-01-31", "2023-03-01"}, {"2023-03-02", "2023-03-15"}}},
+		{start: "2023-01-01", end: "2023-03-15", chunkDays: -1, want: nil},
+	}
+
+	for _, tc := range testCases {
+		reqs := New(WithStartDate(dateFromMust(tc.start)), WithEndDate(dateFromMust(tc.end)), WithChunkDays(tc.chunkDays)).chunks()
+		if len(reqs) != len(tc.want) {
+			t.Errorf("chunks(%v, %v) is %v chunks, want %v", tc.start, tc.end, len(reqs), len(tc.want))
+			continue
+		}
+		for i, req := range reqs {
+			if req.StartDate.String() != tc.want[i][0] || req.EndDate.String() != tc.want[i][1] {
+				t.Errorf("chunk[%d] is %v..%v, want %v..%v", i, req.StartDate, req.EndDate, tc.want[i][0], tc.want[i][1])
+			}
+		}
+	}
+}
+
+func TestGetChunks(t *testing.T) {
+	_, cli := testutil.NewServer(t, func(w http.ResponseWriter, r *http.Request) {
+		start, _ := nasaapi.DateFrom(r.URL.Query().Get("start_date"))
+		end, _ := nasaapi.DateFrom(r.URL.Query().Get("end_date"))
+		if start.String() == "2023-01-11" {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		var resps []*Response
+		for dt := start; !dt.After(end.Time); dt = nasaapi.NewDate(dt.AddDate(0, 0, 1)) {
+			resps = append(resps, &Response{Date: dt})
+		}
+		_ = json.NewEncoder(w).Encode(resps)
+	})
+
+	req := New(
+		WithStartDate(dateFromMust("2023-01-01")),
+		WithEndDate(dateFromMust("2023-01-25")),
+		WithChunkDays(5),
+		WithConcurrency(3),
+		WithClient(cli),
+	)
+	resps, err := req.Get(context.Background())
+	var chunkErr *ChunkError
+	if !errors.As(err, &chunkErr) {
+		t.Fatalf("Get() is \"%v\", want ChunkError", err)
+	}
+	if chunkErr.Chunks != 5 || len(chunkErr.Failures) != 1 || chunkErr.Failures[0].StartDate.String() != "2023-01-11" || chunkErr.Failures[0].EndDate.String() != "2023-01-15" {
+		t.Errorf("ChunkError is %v, want failure of 2023-01-11..2023-01-15", chunkErr)
+	}
+	if !errors.Is(err, ecode.ErrServerError) {
+		t.Errorf("errors.Is(%v, %v) is false, want true", err, ecode.ErrServerError)
+	}
+	if len(resps) != 20 {
+		t.Fatalf("Get() returns %d responses, want 20", len(resps))
+	}
+	for i := 1; i < len(resps); i++ {
+		if !resps[i-1].Date.Before(resps[i].Date.Time) {
+			t.Errorf("responses are not in date order: %v, %v", resps[i-1].Date, resps[i].Date)
+		}
+	}
+
+	req = New(
+		WithStartDate(dateFromMust("2023-01-16")),
+		WithEndDate(dateFromMust("2023-01-25")),
+		WithChunkDays(3),
+		WithClient(cli),
+	)
+	r, err := req.GetRawData(context.Background())
+	if err != nil {
+		t.Fatalf("GetRawData() is \"%v\", want nil", err)
+	}
+	defer r.Close()
+	b, _ := io.ReadAll(r)
+	var raws []json.RawMessage
+	if err := json.Unmarshal(b, &raws); err != nil || len(raws) != 10 {
+		t.Errorf("GetRawData() returns %d elements (%v), want 10", len(raws), err)
+	}
+}
+
+/* MIT License
+ *
+ * Copyright 2023 Spiegel
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
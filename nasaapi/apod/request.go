@@ -1,6 +1,7 @@
 package apod
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"io"
@@ -10,11 +11,17 @@ import (
 
 	"github.com/goark/apod/ecode"
 	"github.com/goark/apod/nasaapi"
+	"github.com/goark/apod/nasaapi/cache"
 	"github.com/goark/errs"
 )
 
 const APIPath = "/planetary/apod"
 
+const (
+	CacheTTLPast  = 30 * 24 * time.Hour // TTL of cached data for past dates
+	CacheTTLToday = time.Hour           // TTL of cached data including today
+)
+
 // Request is for context of APOD API.
 type Request struct {
 	Date      nasaapi.Date `json:"date,omitempty"`       // The date of the APOD image to retrieve
@@ -23,6 +30,11 @@ type Request struct {
 	Count     int          `json:"count,omitempty"`      // If this is specified then count randomly chosen images will be returned. Cannot be used with date or start_date and end_date.
 	Thumbs    bool         `json:"thumbs,omitempty"`     // Return the URL of video thumbnail. If an APOD is not a video, this parameter is ignored.
 	APIKey    string       `json:"api_key"`              // api.nasa.gov key for expanded usage
+	client    *nasaapi.Client
+	cache     *cache.Cache
+
+	chunkDays   int
+	concurrency int
 }
 
 type Opts func(*Request)
@@ -63,6 +75,36 @@ func WithEndDate(endDate nasaapi.Date) Opts {
 	}
 }
 
+// WithMonth returns function for setting Request.StartDate and Request.EndDate to the whole month.
+// The range is clamped to FirstDate and today.
+func WithMonth(year int, month time.Month) Opts {
+	start := time.Date(year, month, 1, 0, 0, 0, 0, time.UTC)
+	return WithRange(nasaapi.NewDate(start), nasaapi.NewDate(start.AddDate(0, 1, -1)))
+}
+
+// WithYear returns function for setting Request.StartDate and Request.EndDate to the whole year.
+// The range is clamped to FirstDate and today.
+func WithYear(year int) Opts {
+	return WithRange(nasaapi.NewDate(time.Date(year, time.January, 1, 0, 0, 0, 0, time.UTC)), nasaapi.NewDate(time.Date(year, time.December, 31, 0, 0, 0, 0, time.UTC)))
+}
+
+// WithRange returns function for setting Request.StartDate and Request.EndDate.
+// The range is clamped to FirstDate and today.
+func WithRange(start, end nasaapi.Date) Opts {
+	return func(ctx *Request) {
+		if ctx != nil {
+			if start.Before(FirstDate.Time) {
+				start = FirstDate
+			}
+			if today := nasaapi.Today(); end.After(today.Time) {
+				end = today
+			}
+			ctx.StartDate = start
+			ctx.EndDate = end
+		}
+	}
+}
+
 // WithCount returns function for setting Request.Count.
 func WithCount(count int) Opts {
 	return func(ctx *Request) {
@@ -90,6 +132,32 @@ func WithAPIKey(apiKey string) Opts {
 	}
 }
 
+// WithClient returns function for setting nasaapi.Client.
+func WithClient(client *nasaapi.Client) Opts {
+	return func(ctx *Request) {
+		if ctx != nil {
+			ctx.client = client
+		}
+	}
+}
+
+// WithCache returns function for setting cache.Cache. If cache is nil, responses are not cached.
+func WithCache(c *cache.Cache) Opts {
+	return func(ctx *Request) {
+		if ctx != nil {
+			ctx.cache = c
+		}
+	}
+}
+
+// Client method returns nasaapi.Client instance for requesting. If not set, returns nasaapi.DefaultClient().
+func (apod *Request) Client() *nasaapi.Client {
+	if apod == nil || apod.client == nil {
+		return nasaapi.DefaultClient()
+	}
+	return apod.client
+}
+
 // Encode returns JSON string.
 func (apod *Request) Encode() (string, error) {
 	if apod == nil {
@@ -112,16 +180,32 @@ func (apod *Request) String() string {
 }
 
 // Get method gets APOD data from NASA API, and returns []*Response instance.
+// Long date range is split into chunks (see WithChunkDays function), and the results are merged in date order.
+// If some chunks fail, it returns merged results of succeeded chunks with ChunkError.
 func (apod *Request) Get(ctx context.Context) ([]*Response, error) {
+	resps, _, err := apod.GetWithRateLimit(ctx)
+	return resps, err
+}
+
+// GetWithRateLimit method gets APOD data from NASA API, and returns []*Response instance with rate limit information.
+// If the data is read from cache, rate limit information is zero value.
+func (apod *Request) GetWithRateLimit(ctx context.Context) ([]*Response, nasaapi.RateLimit, error) {
 	if apod == nil {
-		return nil, errs.Wrap(ecode.ErrNullPointer)
+		return nil, nasaapi.RateLimit{}, errs.Wrap(ecode.ErrNullPointer)
 	}
-	resp, err := apod.GetRawData(ctx)
+	if err := apod.Validate(); err != nil {
+		return nil, nasaapi.RateLimit{}, errs.Wrap(err, errs.WithContext("config", apod))
+	}
+	if reqs := apod.chunks(); len(reqs) > 0 {
+		return apod.getChunks(ctx, reqs)
+	}
+	r, rl, err := apod.fetch(ctx)
 	if err != nil {
-		return nil, err
+		return nil, nasaapi.RateLimit{}, err
 	}
-	defer resp.Close()
-	return decode(resp, apod.isSingle())
+	defer r.Close()
+	resps, err := decode(r, apod.isSingle())
+	return resps, rl, err
 }
 
 // GetRawData method gets APOD data from NASA API, and returns raw response string.
@@ -129,11 +213,63 @@ func (apod *Request) GetRawData(ctx context.Context) (io.ReadCloser, error) {
 	if apod == nil {
 		return nil, errs.Wrap(ecode.ErrNullPointer)
 	}
-	q, err := apod.makeQuery()
+	if err := apod.Validate(); err != nil {
+		return nil, errs.Wrap(err, errs.WithContext("config", apod))
+	}
+	if reqs := apod.chunks(); len(reqs) > 0 {
+		return apod.getRawChunks(ctx, reqs)
+	}
+	r, _, err := apod.fetch(ctx)
+	return r, err
+}
+
+func (apod *Request) fetch(ctx context.Context) (io.ReadCloser, nasaapi.RateLimit, error) {
+	q := apod.makeQuery()
+	cli := apod.Client()
+	key, ttl := apod.cacheKey(cli, q)
+	if apod.cache != nil && ttl > 0 {
+		if b, ok := apod.cache.Get(key); ok {
+			return io.NopCloser(bytes.NewReader(b)), nasaapi.RateLimit{}, nil
+		}
+	}
+	resp, err := cli.Get(ctx, cli.URL(APIPath, q))
+	if err != nil {
+		return nil, nasaapi.RateLimit{}, errs.Wrap(err)
+	}
+	rl, _ := nasaapi.RateLimitFrom(resp.Header)
+	if apod.cache == nil || ttl <= 0 {
+		return resp.Body, rl, nil
+	}
+	defer resp.Body.Close()
+	b, err := io.ReadAll(resp.Body)
 	if err != nil {
-		return nil, errs.Wrap(err)
+		return nil, rl, errs.Wrap(err)
 	}
-	return nasaapi.Request(ctx, APIPath, q)
+	_ = apod.cache.Put(key, b, ttl) // cache is best effort
+	return io.NopCloser(bytes.NewReader(b)), rl, nil
+}
+
+// cacheKey method returns key and TTL of cache for the query.
+// Query with count parameter is never cached (TTL is zero).
+func (apod *Request) cacheKey(cli *nasaapi.Client, q url.Values) (string, time.Duration) {
+	if apod.Count > 0 {
+		return "", 0
+	}
+	nq := url.Values{}
+	for k, v := range q {
+		if k != "api_key" {
+			nq[k] = v
+		}
+	}
+	latest := apod.Date
+	if latest.IsZero() {
+		latest = apod.EndDate
+	}
+	ttl := CacheTTLPast
+	if latest.IsZero() || !latest.Before(nasaapi.Today().Time) {
+		ttl = CacheTTLToday
+	}
+	return cli.URL(APIPath, nq).String(), ttl
 }
 
 func (apod *Request) isSingle() bool {
@@ -146,24 +282,17 @@ func (apod *Request) isSingle() bool {
 	return false
 }
 
-func (apod *Request) makeQuery() (url.Values, error) {
+// makeQuery method returns query parameters of the request.
+// The request must be validated by Validate method in advance.
+func (apod *Request) makeQuery() url.Values {
 	v := url.Values{}
 	if !apod.Date.IsZero() {
-		if !apod.StartDate.IsZero() || !apod.EndDate.IsZero() || apod.Count > 0 {
-			return nil, errs.Wrap(ecode.ErrCombination, errs.WithContext("config", apod))
-		}
 		v.Set("date", apod.Date.Format(time.DateOnly))
 	}
 	if !apod.StartDate.IsZero() {
-		if !apod.Date.IsZero() || apod.Count > 0 {
-			return nil, errs.Wrap(ecode.ErrCombination, errs.WithContext("config", apod))
-		}
 		v.Set("start_date", apod.StartDate.Format(time.DateOnly))
 	}
 	if !apod.EndDate.IsZero() {
-		if apod.StartDate.IsZero() || !apod.Date.IsZero() || apod.Count > 0 {
-			return nil, errs.Wrap(ecode.ErrCombination, errs.WithContext("config", apod))
-		}
 		v.Set("end_date", apod.EndDate.Format(time.DateOnly))
 	}
 	if apod.Count > 0 {
@@ -177,7 +306,7 @@ func (apod *Request) makeQuery() (url.Values, error) {
 	} else {
 		v.Set("api_key", nasaapi.DefaultAPIKey)
 	}
-	return v, nil
+	return v
 }
 
 /* MIT License
@@ -3,6 +3,7 @@ package apod
 import (
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/goark/apod/ecode"
 	"github.com/goark/apod/nasaapi"
@@ -148,9 +149,9 @@ func TestDate(t *testing.T) {
 			WithThumbs(tc.thumbs),
 			WithAPIKey(tc.apiKey),
 		)
-		_, err := req.makeQuery()
+		err := req.Validate()
 		if !errors.Is(err, tc.err) {
-			t.Errorf("makeQuery() is \"%v\", want \"%v\"", err, tc.err)
+			t.Errorf("Validate() is \"%v\", want \"%v\"", err, tc.err)
 		}
 		if err == nil {
 			if got, err := req.Encode(); err != nil {
@@ -163,6 +164,30 @@ func TestDate(t *testing.T) {
 	}
 }
 
+func TestWithRange(t *testing.T) {
+	today := nasaapi.Today()
+	testCases := []struct {
+		opt   Opts
+		start string
+		end   string
+	}{
+		{opt: WithMonth(2023, time.February), start: "2023-02-01", end: "2023-02-28"},
+		{opt: WithYear(1999), start: "1999-01-01", end: "1999-12-31"},
+		{opt: WithYear(1995), start: "1995-06-16", end: "1995-12-31"},
+		{opt: WithMonth(today.Year(), today.Month()), start: nasaapi.NewDate(today.AddDate(0, 0, 1-today.Day())).String(), end: today.String()},
+	}
+
+	for _, tc := range testCases {
+		req := New(tc.opt)
+		if req.StartDate.String() != tc.start || req.EndDate.String() != tc.end {
+			t.Errorf("range is %v..%v, want %v..%v", req.StartDate, req.EndDate, tc.start, tc.end)
+		}
+		if err := req.Validate(); err != nil {
+			t.Errorf("Validate() is \"%v\", want nil", err)
+		}
+	}
+}
+
 /* MIT License
  *
  * Copyright 2023 Spiegel
@@ -0,0 +1,103 @@
+package apod
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/goark/apod/ecode"
+	"github.com/goark/apod/nasaapi"
+)
+
+const MaxCount = 100 // Maximum value of count parameter
+
+// FirstDate is the date of the first APOD.
+var FirstDate = nasaapi.NewDate(time.Date(1995, time.June, 16, 0, 0, 0, 0, time.UTC))
+
+// ValidationError is error of a parameter in Request.
+type ValidationError struct {
+	Param string // name of parameter (e.g. "start_date")
+	Value string // value of parameter
+	Err   error  // error in ecode package
+}
+
+// Error method returns error message. (implementation of error interface)
+func (e *ValidationError) Error() string {
+	if e == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("%s=%s: %v", e.Param, e.Value, e.Err)
+}
+
+// Unwrap method returns error in ecode package. (used in errors.Is function)
+func (e *ValidationError) Unwrap() error {
+	if e == nil {
+		return nil
+	}
+	return e.Err
+}
+
+// Validate method checks parameters of Request, and returns joined errors (*ValidationError) for all problems.
+func (apod *Request) Validate() error {
+	if apod == nil {
+		return ecode.ErrNullPointer
+	}
+	var errList []error
+	if !apod.Date.IsZero() && (!apod.StartDate.IsZero() || !apod.EndDate.IsZero() || apod.Count != 0) {
+		errList = append(errList, &ValidationError{Param: "date", Value: apod.Date.String(), Err: ecode.ErrCombination})
+	}
+	if !apod.StartDate.IsZero() && apod.Count != 0 {
+		errList = append(errList, &ValidationError{Param: "start_date", Value: apod.StartDate.String(), Err: ecode.ErrCombination})
+	}
+	if !apod.EndDate.IsZero() && apod.StartDate.IsZero() {
+		errList = append(errList, &ValidationError{Param: "end_date", Value: apod.EndDate.String(), Err: ecode.ErrCombination})
+	}
+	if apod.Count < 0 || apod.Count > MaxCount {
+		errList = append(errList, &ValidationError{Param: "count", Value: strconv.Itoa(apod.Count), Err: ecode.ErrCountOutOfRange})
+	}
+	today := nasaapi.Today()
+	for _, p := range []struct {
+		name string
+		date nasaapi.Date
+	}{
+		{name: "date", date: apod.Date},
+		{name: "start_date", date: apod.StartDate},
+		{name: "end_date", date: apod.EndDate},
+	} {
+		switch {
+		case p.date.IsZero():
+		case p.date.Before(FirstDate.Time):
+			errList = append(errList, &ValidationError{Param: p.name, Value: p.date.String(), Err: ecode.ErrDateBeforeFirst})
+		case p.date.After(today.Time):
+			errList = append(errList, &ValidationError{Param: p.name, Value: p.date.String(), Err: ecode.ErrFutureDate})
+		}
+	}
+	if !apod.StartDate.IsZero() && !apod.EndDate.IsZero() && apod.EndDate.Before(apod.StartDate.Time) {
+		errList = append(errList, &ValidationError{Param: "end_date", Value: apod.EndDate.String(), Err: ecode.ErrEndBeforeStart})
+	}
+	return errors.Join(errList...)
+}
+
+/* MIT License
+ *
+ * Copyright 2023 Spiegel
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
@@ -0,0 +1,73 @@
+package apod
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/goark/apod/ecode"
+	"github.com/goark/apod/nasaapi"
+)
+
+func TestValidate(t *testing.T) {
+	tomorrow := nasaapi.NewDate(nasaapi.Today().AddDate(0, 0, 1))
+	testCases := []struct {
+		req  *Request
+		errs []error
+	}{
+		{req: New(), errs: nil},
+		{req: New(WithDate(dateFromMust("1995-06-16"))), errs: nil},
+		{req: New(WithStartDate(dateFromMust("2023-02-01")), WithEndDate(dateFromMust("2023-02-22"))), errs: nil},
+		{req: New(WithCount(100)), errs: nil},
+		{req: New(WithDate(dateFromMust("1995-06-15"))), errs: []error{ecode.ErrDateBeforeFirst}},
+		{req: New(WithDate(tomorrow)), errs: []error{ecode.ErrFutureDate}},
+		{req: New(WithCount(101)), errs: []error{ecode.ErrCountOutOfRange}},
+		{req: New(WithStartDate(dateFromMust("2023-02-22")), WithEndDate(dateFromMust("2023-02-01"))), errs: []error{ecode.ErrEndBeforeStart}},
+		{req: New(WithStartDate(dateFromMust("1995-01-01")), WithEndDate(tomorrow)), errs: []error{ecode.ErrDateBeforeFirst, ecode.ErrFutureDate}},
+		{req: New(WithDate(dateFromMust("2023-02-22")), WithCount(101)), errs: []error{ecode.ErrCombination, ecode.ErrCountOutOfRange}},
+	}
+
+	for _, tc := range testCases {
+		err := tc.req.Validate()
+		if len(tc.errs) == 0 {
+			if err != nil {
+				t.Errorf("Validate(%v) is \"%v\", want nil", tc.req, err)
+			}
+			continue
+		}
+		var verr *ValidationError
+		if !errors.As(err, &verr) {
+			t.Errorf("Validate(%v) is \"%v\", want ValidationError", tc.req, err)
+		}
+		for _, e := range tc.errs {
+			if !errors.Is(err, e) {
+				t.Errorf("Validate(%v) is \"%v\", want \"%v\"", tc.req, err, e)
+			}
+		}
+		if n := len(err.(interface{ Unwrap() []error }).Unwrap()); n != len(tc.errs) {
+			t.Errorf("Validate(%v) returns %d errors, want %d", tc.req, n, len(tc.errs))
+		}
+	}
+}
+
+/* MIT License
+ *
+ * Copyright 2023 Spiegel
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
@@ -0,0 +1,215 @@
+package cache
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/goark/apod/ecode"
+	"github.com/goark/errs"
+)
+
+const fileExt = ".json"
+
+// Cache is on-disk cache of response data from NASA API.
+type Cache struct {
+	dir string
+}
+
+// entry is format of cache file.
+type entry struct {
+	Key     string    `json:"key"`
+	Expires time.Time `json:"expires"`
+	Data    []byte    `json:"data"`
+}
+
+// Stats is statistics of cache directory.
+type Stats struct {
+	Dir     string `json:"dir"`
+	Entries int    `json:"entries"`
+	Expired int    `json:"expired"`
+	Size    int64  `json:"size"`
+}
+
+// New returns new Cache instance.
+func New(dir string) *Cache {
+	return &Cache{dir: dir}
+}
+
+// Dir method returns path of cache directory.
+func (c *Cache) Dir() string {
+	if c == nil {
+		return ""
+	}
+	return c.dir
+}
+
+// Get method returns cached data for the key. If the data is not found or expired, ok is false.
+func (c *Cache) Get(key string) (data []byte, ok bool) {
+	if c == nil {
+		return nil, false
+	}
+	e, err := readEntry(c.path(key))
+	if err != nil || e.Key != key || !time.Now().Before(e.Expires) {
+		return nil, false
+	}
+	return e.Data, true
+}
+
+// Put method stores data for the key with TTL.
+func (c *Cache) Put(key string, data []byte, ttl time.Duration) error {
+	if c == nil {
+		return errs.Wrap(ecode.ErrNullPointer)
+	}
+	if ttl <= 0 {
+		return nil
+	}
+	b, err := json.Marshal(&entry{Key: key, Expires: time.Now().Add(ttl), Data: data})
+	if err != nil {
+		return errs.Wrap(err, errs.WithContext("key", key))
+	}
+	if err := os.MkdirAll(c.dir, 0700); err != nil {
+		return errs.Wrap(err, errs.WithContext("dir", c.dir))
+	}
+	// write to temporary file, and rename it (atomic update)
+	tmp, err := os.CreateTemp(c.dir, "tmp-*")
+	if err != nil {
+		return errs.Wrap(err, errs.WithContext("dir", c.dir))
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(b); err != nil {
+		tmp.Close()
+		return errs.Wrap(err, errs.WithContext("path", tmp.Name()))
+	}
+	if err := tmp.Close(); err != nil {
+		return errs.Wrap(err, errs.WithContext("path", tmp.Name()))
+	}
+	path := c.path(key)
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return errs.Wrap(err, errs.WithContext("path", path))
+	}
+	return nil
+}
+
+// Stats method returns statistics of cache directory.
+func (c *Cache) Stats() (Stats, error) {
+	if c == nil {
+		return Stats{}, errs.Wrap(ecode.ErrNullPointer)
+	}
+	stats := Stats{Dir: c.dir}
+	now := time.Now()
+	err := c.walk(func(path string, info fs.FileInfo) error {
+		stats.Entries++
+		stats.Size += info.Size()
+		if e, err := readEntry(path); err != nil || !now.Before(e.Expires) {
+			stats.Expired++
+		}
+		return nil
+	})
+	return stats, err
+}
+
+// Clear method removes all cache files.
+func (c *Cache) Clear() (int, error) {
+	if c == nil {
+		return 0, errs.Wrap(ecode.ErrNullPointer)
+	}
+	count := 0
+	err := c.walk(func(path string, info fs.FileInfo) error {
+		if err := os.Remove(path); err != nil {
+			return errs.Wrap(err, errs.WithContext("path", path))
+		}
+		count++
+		return nil
+	})
+	return count, err
+}
+
+// Prune method removes expired (or broken) cache files.
+func (c *Cache) Prune() (int, error) {
+	if c == nil {
+		return 0, errs.Wrap(ecode.ErrNullPointer)
+	}
+	count := 0
+	now := time.Now()
+	err := c.walk(func(path string, info fs.FileInfo) error {
+		if e, err := readEntry(path); err == nil && now.Before(e.Expires) {
+			return nil
+		}
+		if err := os.Remove(path); err != nil {
+			return errs.Wrap(err, errs.WithContext("path", path))
+		}
+		count++
+		return nil
+	})
+	return count, err
+}
+
+func (c *Cache) path(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return filepath.Join(c.dir, hex.EncodeToString(sum[:])+fileExt)
+}
+
+func (c *Cache) walk(fn func(path string, info fs.FileInfo) error) error {
+	entries, err := os.ReadDir(c.dir)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil
+		}
+		return errs.Wrap(err, errs.WithContext("dir", c.dir))
+	}
+	for _, de := range entries {
+		if de.IsDir() || !strings.HasSuffix(de.Name(), fileExt) {
+			continue
+		}
+		info, err := de.Info()
+		if err != nil {
+			continue
+		}
+		if err := fn(filepath.Join(c.dir, de.Name()), info); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func readEntry(path string) (*entry, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, errs.Wrap(err, errs.WithContext("path", path))
+	}
+	var e entry
+	if err := json.Unmarshal(b, &e); err != nil {
+		return nil, errs.Wrap(err, errs.WithContext("path", path))
+	}
+	return &e, nil
+}
+
+/* MIT License
+ *
+ * Copyright 2023 Spiegel
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
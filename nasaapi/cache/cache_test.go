@@ -0,0 +1,73 @@
+package cache
+
+import (
+	"testing"
+	"time"
+)
+
+func TestCache(t *testing.T) {
+	c := New(t.TempDir())
+	if _, ok := c.Get("foo"); ok {
+		t.Error("Get(foo) is ok in empty cache, want not ok")
+	}
+	if err := c.Put("foo", []byte(`{"foo":1}`), time.Hour); err != nil {
+		t.Fatalf("Put(foo) is \"%v\", want nil", err)
+	}
+	if err := c.Put("bar", []byte(`{"bar":2}`), time.Nanosecond); err != nil {
+		t.Fatalf("Put(bar) is \"%v\", want nil", err)
+	}
+	if err := c.Put("baz", []byte(`{"baz":3}`), 0); err != nil {
+		t.Fatalf("Put(baz) is \"%v\", want nil", err)
+	}
+	time.Sleep(time.Millisecond)
+
+	if b, ok := c.Get("foo"); !ok || string(b) != `{"foo":1}` {
+		t.Errorf("Get(foo) is \"%s\" (%v), want \"%s\" (true)", b, ok, `{"foo":1}`)
+	}
+	if _, ok := c.Get("bar"); ok {
+		t.Error("Get(bar) is ok after expired, want not ok")
+	}
+	if _, ok := c.Get("baz"); ok {
+		t.Error("Get(baz) is ok with zero TTL, want not ok")
+	}
+
+	stats, err := c.Stats()
+	if err != nil {
+		t.Fatalf("Stats() is \"%v\", want nil", err)
+	}
+	if stats.Entries != 2 || stats.Expired != 1 {
+		t.Errorf("Stats() is %+v, want 2 entries and 1 expired", stats)
+	}
+	if n, err := c.Prune(); err != nil || n != 1 {
+		t.Errorf("Prune() is %v (%v), want 1 (nil)", n, err)
+	}
+	if n, err := c.Clear(); err != nil || n != 1 {
+		t.Errorf("Clear() is %v (%v), want 1 (nil)", n, err)
+	}
+	if _, ok := c.Get("foo"); ok {
+		t.Error("Get(foo) is ok after cleared, want not ok")
+	}
+}
+
+/* MIT License
+ *
+ * Copyright 2023 Spiegel
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
@@ -0,0 +1,282 @@
+package nasaapi
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/goark/apod/ecode"
+	"github.com/goark/errs"
+)
+
+const (
+	DefaultBaseURL   = "https://api.nasa.gov" // Default base URL of NASA API
+	DefaultUserAgent = "goark-apod"           // Default User-Agent string
+)
+
+// Client is client class for NASA API.
+type Client struct {
+	baseURL *url.URL
+	client  *http.Client
+	header  http.Header
+
+	retryPolicy RetryPolicy
+	retryNotify RetryNotify
+	limiter     *KeyLimiter
+
+	mutex     sync.RWMutex
+	rateLimit *RateLimit
+}
+
+// ClientOpts is option function type for Client.
+type ClientOpts func(*Client)
+
+// RequestOpts is option function type for http.Request.
+type RequestOpts func(*http.Request)
+
+var defaultClient = NewClient()
+
+// DefaultClient returns default Client instance.
+func DefaultClient() *Client {
+	return defaultClient
+}
+
+// NewClient returns new Client instance.
+func NewClient(opts ...ClientOpts) *Client {
+	u, _ := url.Parse(DefaultBaseURL)
+	cli := &Client{
+		baseURL: u,
+		client:  &http.Client{},
+		header:  http.Header{},
+		limiter: DefaultKeyLimiter(),
+	}
+	cli.header.Set("User-Agent", DefaultUserAgent)
+	for _, opt := range opts {
+		opt(cli)
+	}
+	return cli
+}
+
+// WithBaseURL returns function for setting base URL of NASA API.
+func WithBaseURL(u *url.URL) ClientOpts {
+	return func(c *Client) {
+		if c != nil && u != nil {
+			c.baseURL = u
+		}
+	}
+}
+
+// WithHTTPClient returns function for setting http.Client.
+func WithHTTPClient(cli *http.Client) ClientOpts {
+	return func(c *Client) {
+		if c != nil && cli != nil {
+			c.client = cli
+		}
+	}
+}
+
+// WithTransport returns function for setting http.RoundTripper.
+func WithTransport(rt http.RoundTripper) ClientOpts {
+	return func(c *Client) {
+		if c != nil && rt != nil {
+			cli := *c.client
+			cli.Transport = rt
+			c.client = &cli
+		}
+	}
+}
+
+// WithTimeout returns function for setting timeout of HTTP requests.
+func WithTimeout(timeout time.Duration) ClientOpts {
+	return func(c *Client) {
+		if c != nil && timeout > 0 {
+			cli := *c.client
+			cli.Timeout = timeout
+			c.client = &cli
+		}
+	}
+}
+
+// WithUserAgent returns function for setting User-Agent header.
+func WithUserAgent(ua string) ClientOpts {
+	return func(c *Client) {
+		if c != nil && len(ua) > 0 {
+			c.header.Set("User-Agent", ua)
+		}
+	}
+}
+
+// WithHeader returns function for setting default request header.
+func WithHeader(name, value string) ClientOpts {
+	return func(c *Client) {
+		if c != nil {
+			c.header.Set(name, value)
+		}
+	}
+}
+
+// Clone method returns copy of Client with additional options.
+// It shares http.Client and KeyLimiter with the original, so it is useful for NASA APIs on other hosts (e.g. images-api.nasa.gov).
+func (c *Client) Clone(opts ...ClientOpts) *Client {
+	if c == nil {
+		return NewClient(opts...)
+	}
+	baseURL := *c.baseURL
+	cli := &Client{
+		baseURL:     &baseURL,
+		client:      c.client,
+		header:      c.header.Clone(),
+		retryPolicy: c.retryPolicy,
+		retryNotify: c.retryNotify,
+		limiter:     c.limiter,
+	}
+	for _, opt := range opts {
+		opt(cli)
+	}
+	return cli
+}
+
+// BaseURL method returns base URL of NASA API.
+func (c *Client) BaseURL() *url.URL {
+	if c == nil {
+		return nil
+	}
+	u := *c.baseURL
+	return &u
+}
+
+// URL method returns URL of NASA API from path and query parameters.
+func (c *Client) URL(path string, q url.Values) *url.URL {
+	if c == nil {
+		return nil
+	}
+	u := c.baseURL.JoinPath(path)
+	u.RawQuery = q.Encode()
+	return u
+}
+
+// Request method requests to NASA API, and returns response data.
+func (c *Client) Request(ctx context.Context, path string, q url.Values) (io.ReadCloser, error) {
+	if c == nil {
+		return nil, errs.Wrap(ecode.ErrNullPointer)
+	}
+	resp, err := c.Get(ctx, c.URL(path, q))
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return resp.Body, nil
+}
+
+// RequestJSON method requests to NASA API, and decodes JSON response data into v.
+func (c *Client) RequestJSON(ctx context.Context, path string, q url.Values, v interface{}) error {
+	r, err := c.Request(ctx, path, q)
+	if err != nil {
+		return errs.Wrap(err)
+	}
+	defer r.Close()
+	if err := json.NewDecoder(r).Decode(v); err != nil {
+		return errs.Wrap(err, errs.WithContext("path", path))
+	}
+	return nil
+}
+
+// Get method requests to URL by GET method with RetryPolicy, and returns http.Response instance.
+func (c *Client) Get(ctx context.Context, u *url.URL, opts ...RequestOpts) (*http.Response, error) {
+	if c == nil {
+		return nil, errs.Wrap(ecode.ErrNullPointer)
+	}
+	var resp *http.Response
+	err := c.Retry(ctx, func() error {
+		var err error
+		resp, err = c.GetOnce(ctx, u, opts...)
+		return err
+	})
+	return resp, err
+}
+
+// GetOnce method requests to URL by GET method without retrying, and returns http.Response instance.
+// Requests with api_key query parameter wait for KeyLimiter.
+func (c *Client) GetOnce(ctx context.Context, u *url.URL, opts ...RequestOpts) (*http.Response, error) {
+	if c == nil {
+		return nil, errs.Wrap(ecode.ErrNullPointer)
+	}
+	if apiKey := u.Query().Get("api_key"); len(apiKey) > 0 && c.limiter != nil {
+		if err := c.limiter.Wait(ctx, apiKey); err != nil {
+			return nil, errs.Wrap(err, errs.WithContext("url", u.Path))
+		}
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return nil, errs.Wrap(err, errs.WithContext("url", u.String()))
+	}
+	for name, values := range c.header {
+		for _, v := range values {
+			req.Header.Add(name, v)
+		}
+	}
+	for _, opt := range opts {
+		opt(req)
+	}
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, errs.Wrap(err, errs.WithContext("url", u.String()))
+	}
+	if rl, ok := RateLimitFrom(resp.Header); ok {
+		c.mutex.Lock()
+		c.rateLimit = &rl
+		c.mutex.Unlock()
+	}
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusBadRequest {
+		defer resp.Body.Close()
+		return nil, errs.Wrap(newAPIError(resp), errs.WithContext("url", u.String()))
+	}
+	return resp, nil
+}
+
+// RateLimit method returns rate limit information in the last response from NASA API.
+// If no rate limit information has been received, ok is false.
+func (c *Client) RateLimit() (rl RateLimit, ok bool) {
+	if c == nil {
+		return RateLimit{}, false
+	}
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+	if c.rateLimit == nil {
+		return RateLimit{}, false
+	}
+	return *c.rateLimit, true
+}
+
+// WithRequestHeader returns function for setting request header.
+func WithRequestHeader(name, value string) RequestOpts {
+	return func(req *http.Request) {
+		req.Header.Set(name, value)
+	}
+}
+
+/* MIT License
+ *
+ * Copyright 2023 Spiegel
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
@@ -0,0 +1,126 @@
+package nasaapi
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/goark/apod/ecode"
+)
+
+func TestClientURL(t *testing.T) {
+	testCases := []struct {
+		base string
+		path string
+		q    url.Values
+		want string
+	}{
+		{base: "https://api.nasa.gov", path: "/foo/bar", q: url.Values{"hoge": []string{"hage"}}, want: "https://api.nasa.gov/foo/bar?hoge=hage"},
+		{base: "http://localhost:8080/nasa/", path: "/foo/bar", q: url.Values{}, want: "http://localhost:8080/nasa/foo/bar"},
+	}
+
+	for _, tc := range testCases {
+		u, err := url.Parse(tc.base)
+		if err != nil {
+			t.Fatalf("url.Parse(\"%v\") is \"%v\", want nil", tc.base, err)
+		}
+		got := NewClient(WithBaseURL(u)).URL(tc.path, tc.q)
+		if got.String() != tc.want {
+			t.Errorf("Client.URL() is \"%v\" , want \"%v\"", got.String(), tc.want)
+		}
+	}
+}
+
+func TestClientRequest(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/ok" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = io.WriteString(w, r.Header.Get("User-Agent"))
+	}))
+	defer ts.Close()
+	u, _ := url.Parse(ts.URL)
+	cli := NewClient(WithBaseURL(u), WithUserAgent("test-agent"))
+
+	r, err := cli.Request(context.Background(), "/ok", url.Values{})
+	if err != nil {
+		t.Fatalf("Client.Request() is \"%v\", want nil", err)
+	}
+	defer r.Close()
+	b, _ := io.ReadAll(r)
+	if string(b) != "test-agent" {
+		t.Errorf("User-Agent is \"%v\", want \"%v\"", string(b), "test-agent")
+	}
+
+	if _, err := cli.Request(context.Background(), "/ng", url.Values{}); !errors.Is(err, ecode.ErrHTTPStatus) {
+		t.Errorf("Client.Request() is \"%v\", want \"%v\"", err, ecode.ErrHTTPStatus)
+	}
+}
+
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) { return f(req) }
+
+func TestClientTransport(t *testing.T) {
+	var got string
+	cli := NewClient(WithTransport(roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		got = req.URL.String()
+		return &http.Response{StatusCode: http.StatusOK, Body: http.NoBody, Header: http.Header{}, Request: req}, nil
+	})))
+	if _, err := cli.Request(context.Background(), "/planetary/apod", url.Values{"api_key": []string{"DEMO_KEY"}}); err != nil {
+		t.Fatalf("Client.Request() is \"%v\", want nil", err)
+	}
+	if want := "https://api.nasa.gov/planetary/apod?api_key=DEMO_KEY"; got != want {
+		t.Errorf("requested URL is \"%v\", want \"%v\"", got, want)
+	}
+}
+
+func TestClientClone(t *testing.T) {
+	u, _ := url.Parse("https://images-api.nasa.gov")
+	cli := NewClient(WithUserAgent("test-agent"))
+	clone := cli.Clone(WithBaseURL(u), WithHeader("X-Foo", "bar"))
+
+	if got, want := clone.URL("/search", url.Values{}).String(), "https://images-api.nasa.gov/search"; got != want {
+		t.Errorf("Client.URL() is \"%v\", want \"%v\"", got, want)
+	}
+	if got, want := cli.URL("/search", url.Values{}).String(), "https://api.nasa.gov/search"; got != want {
+		t.Errorf("Client.URL() of original is \"%v\", want \"%v\"", got, want)
+	}
+	if got := clone.header.Get("User-Agent"); got != "test-agent" {
+		t.Errorf("User-Agent is \"%v\", want \"%v\"", got, "test-agent")
+	}
+	if got := cli.header.Get("X-Foo"); got != "" {
+		t.Errorf("X-Foo header of original is \"%v\", want empty", got)
+	}
+	if clone.limiter != cli.limiter {
+		t.Error("KeyLimiter is not shared with original")
+	}
+}
+
+/* MIT License
+ *
+ * Copyright 2023 Spiegel
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
@@ -18,6 +18,21 @@ func NewDate(tm time.Time) Date {
 	return Date{tm}
 }
 
+// PublishingLocation is time zone of publishing APOD (US Eastern).
+var PublishingLocation = func() *time.Location {
+	loc, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		return time.FixedZone("EST", -5*60*60)
+	}
+	return loc
+}()
+
+// Today returns Date instance of today in PublishingLocation.
+func Today() Date {
+	y, m, d := time.Now().In(PublishingLocation).Date()
+	return NewDate(time.Date(y, m, d, 0, 0, 0, 0, time.UTC))
+}
+
 // Stringer with YYYY-MM-DD format.
 func (t Date) String() string {
 	if t.IsZero() {
@@ -0,0 +1,381 @@
+package donki
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/goark/apod/ecode"
+	"github.com/goark/apod/nasaapi"
+	"github.com/goark/errs"
+)
+
+// BasePath is base path of DONKI (Space Weather Database Of Notifications, Knowledge, Information) API.
+const BasePath = "/DONKI"
+
+// EventType is type of DONKI event.
+type EventType string
+
+const (
+	TypeCME                 EventType = "CME"                 // Coronal Mass Ejection
+	TypeCMEAnalysis         EventType = "CMEAnalysis"         // Coronal Mass Ejection Analysis
+	TypeGST                 EventType = "GST"                 // Geomagnetic Storm
+	TypeIPS                 EventType = "IPS"                 // Interplanetary Shock
+	TypeFLR                 EventType = "FLR"                 // Solar Flare
+	TypeSEP                 EventType = "SEP"                 // Solar Energetic Particle
+	TypeMPC                 EventType = "MPC"                 // Magnetopause Crossing
+	TypeRBE                 EventType = "RBE"                 // Radiation Belt Enhancement
+	TypeHSS                 EventType = "HSS"                 // High Speed Stream
+	TypeWSAEnlilSimulations EventType = "WSAEnlilSimulations" // WSA+Enlil Simulation
+	TypeNotifications       EventType = "notifications"       // Notifications
+)
+
+// EventTypes is list of all DONKI event types.
+var EventTypes = []EventType{
+	TypeCME,
+	TypeCMEAnalysis,
+	TypeGST,
+	TypeIPS,
+	TypeFLR,
+	TypeSEP,
+	TypeMPC,
+	TypeRBE,
+	TypeHSS,
+	TypeWSAEnlilSimulations,
+	TypeNotifications,
+}
+
+// EventTypeFrom returns EventType from string (case insensitive).
+func EventTypeFrom(s string) (EventType, error) {
+	for _, typ := range EventTypes {
+		if strings.EqualFold(string(typ), s) {
+			return typ, nil
+		}
+	}
+	return "", errs.Wrap(ecode.ErrInvalidParameter, errs.WithContext("type", s))
+}
+
+// Path method returns path of DONKI API for the event type.
+func (typ EventType) Path() string {
+	return BasePath + "/" + string(typ)
+}
+
+// Request is for context of DONKI API.
+type Request struct {
+	StartDate        nasaapi.Date `json:"startDate,omitempty"` // Starting date (default is 30 days prior to current UTC date)
+	EndDate          nasaapi.Date `json:"endDate,omitempty"`   // Ending date (default is current UTC date)
+	Catalog          string       `json:"catalog,omitempty"`   // Catalog for CMEAnalysis and IPS (ALL, SWRC_CATALOG, JANG_ET_AL_CATALOGS, ...)
+	Location         string       `json:"location,omitempty"`  // Location for IPS (ALL, Earth, MESSENGER, STEREO A, STEREO B)
+	Speed            float64      `json:"speed,omitempty"`     // Lower limit of speed for CMEAnalysis
+	HalfAngle        float64      `json:"halfAngle,omitempty"` // Lower limit of half angle for CMEAnalysis
+	Keyword          string       `json:"keyword,omitempty"`   // Keyword for CMEAnalysis
+	NotificationType string       `json:"type,omitempty"`      // Type for notifications (all, FLR, SEP, CME, IPS, MPC, GST, RBE, report)
+	APIKey           string       `json:"api_key"`             // api.nasa.gov key for expanded usage
+	client           *nasaapi.Client
+}
+
+type Opts func(*Request)
+
+// New returns new Request instance for DONKI API.
+func New(opts ...Opts) *Request {
+	ctx := &Request{}
+	for _, opt := range opts {
+		opt(ctx)
+	}
+	return ctx
+}
+
+// WithStartDate returns function for setting Request.StartDate.
+func WithStartDate(startDate nasaapi.Date) Opts {
+	return func(ctx *Request) {
+		if ctx != nil {
+			ctx.StartDate = startDate
+		}
+	}
+}
+
+// WithEndDate returns function for setting Request.EndDate.
+func WithEndDate(endDate nasaapi.Date) Opts {
+	return func(ctx *Request) {
+		if ctx != nil {
+			ctx.EndDate = endDate
+		}
+	}
+}
+
+// WithCatalog returns function for setting Request.Catalog.
+func WithCatalog(catalog string) Opts {
+	return func(ctx *Request) {
+		if ctx != nil {
+			ctx.Catalog = catalog
+		}
+	}
+}
+
+// WithLocation returns function for setting Request.Location.
+func WithLocation(location string) Opts {
+	return func(ctx *Request) {
+		if ctx != nil {
+			ctx.Location = location
+		}
+	}
+}
+
+// WithSpeed returns function for setting Request.Speed.
+func WithSpeed(speed float64) Opts {
+	return func(ctx *Request) {
+		if ctx != nil {
+			ctx.Speed = speed
+		}
+	}
+}
+
+// WithHalfAngle returns function for setting Request.HalfAngle.
+func WithHalfAngle(halfAngle float64) Opts {
+	return func(ctx *Request) {
+		if ctx != nil {
+			ctx.HalfAngle = halfAngle
+		}
+	}
+}
+
+// WithKeyword returns function for setting Request.Keyword.
+func WithKeyword(keyword string) Opts {
+	return func(ctx *Request) {
+		if ctx != nil {
+			ctx.Keyword = keyword
+		}
+	}
+}
+
+// WithNotificationType returns function for setting Request.NotificationType.
+func WithNotificationType(typ string) Opts {
+	return func(ctx *Request) {
+		if ctx != nil {
+			ctx.NotificationType = typ
+		}
+	}
+}
+
+// WithAPIKey returns function for setting Request.APIKey.
+func WithAPIKey(apiKey string) Opts {
+	return func(ctx *Request) {
+		if ctx != nil {
+			ctx.APIKey = apiKey
+		}
+	}
+}
+
+// WithClient returns function for setting nasaapi.Client.
+func WithClient(client *nasaapi.Client) Opts {
+	return func(ctx *Request) {
+		if ctx != nil {
+			ctx.client = client
+		}
+	}
+}
+
+// Client method returns nasaapi.Client instance for requesting. If not set, returns nasaapi.DefaultClient().
+func (req *Request) Client() *nasaapi.Client {
+	if req == nil || req.client == nil {
+		return nasaapi.DefaultClient()
+	}
+	return req.client
+}
+
+// GetRawData method gets raw response data of the event type from DONKI API.
+func (req *Request) GetRawData(ctx context.Context, typ EventType) (io.ReadCloser, error) {
+	if req == nil {
+		return nil, errs.Wrap(ecode.ErrNullPointer)
+	}
+	q, err := req.makeQuery(typ)
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return req.Client().Request(ctx, typ.Path(), q)
+}
+
+// Events method gets events of the event type from DONKI API.
+func (req *Request) Events(ctx context.Context, typ EventType) ([]Event, error) {
+	switch typ {
+	case TypeCME:
+		return toEvents(req.CMEs(ctx))
+	case TypeCMEAnalysis:
+		return toEvents(req.CMEAnalyses(ctx))
+	case TypeGST:
+		return toEvents(req.GSTs(ctx))
+	case TypeIPS:
+		return toEvents(req.IPSs(ctx))
+	case TypeFLR:
+		return toEvents(req.FLRs(ctx))
+	case TypeSEP:
+		return toEvents(req.SEPs(ctx))
+	case TypeMPC:
+		return toEvents(req.MPCs(ctx))
+	case TypeRBE:
+		return toEvents(req.RBEs(ctx))
+	case TypeHSS:
+		return toEvents(req.HSSs(ctx))
+	case TypeWSAEnlilSimulations:
+		return toEvents(req.WSAEnlilSimulations(ctx))
+	case TypeNotifications:
+		return toEvents(req.Notifications(ctx))
+	default:
+		return nil, errs.Wrap(ecode.ErrInvalidParameter, errs.WithContext("type", typ))
+	}
+}
+
+// CMEs method gets Coronal Mass Ejection events.
+func (req *Request) CMEs(ctx context.Context) ([]*CME, error) {
+	return get[*CME](ctx, req, TypeCME)
+}
+
+// CMEAnalyses method gets Coronal Mass Ejection analyses.
+func (req *Request) CMEAnalyses(ctx context.Context) ([]*CMEAnalysis, error) {
+	return get[*CMEAnalysis](ctx, req, TypeCMEAnalysis)
+}
+
+// GSTs method gets Geomagnetic Storm events.
+func (req *Request) GSTs(ctx context.Context) ([]*GST, error) {
+	return get[*GST](ctx, req, TypeGST)
+}
+
+// IPSs method gets Interplanetary Shock events.
+func (req *Request) IPSs(ctx context.Context) ([]*IPS, error) {
+	return get[*IPS](ctx, req, TypeIPS)
+}
+
+// FLRs method gets Solar Flare events.
+func (req *Request) FLRs(ctx context.Context) ([]*FLR, error) {
+	return get[*FLR](ctx, req, TypeFLR)
+}
+
+// SEPs method gets Solar Energetic Particle events.
+func (req *Request) SEPs(ctx context.Context) ([]*SEP, error) {
+	return get[*SEP](ctx, req, TypeSEP)
+}
+
+// MPCs method gets Magnetopause Crossing events.
+func (req *Request) MPCs(ctx context.Context) ([]*MPC, error) {
+	return get[*MPC](ctx, req, TypeMPC)
+}
+
+// RBEs method gets Radiation Belt Enhancement events.
+func (req *Request) RBEs(ctx context.Context) ([]*RBE, error) {
+	return get[*RBE](ctx, req, TypeRBE)
+}
+
+// HSSs method gets High Speed Stream events.
+func (req *Request) HSSs(ctx context.Context) ([]*HSS, error) {
+	return get[*HSS](ctx, req, TypeHSS)
+}
+
+// WSAEnlilSimulations method gets results of WSA+Enlil simulation.
+func (req *Request) WSAEnlilSimulations(ctx context.Context) ([]*WSAEnlilSimulation, error) {
+	return get[*WSAEnlilSimulation](ctx, req, TypeWSAEnlilSimulations)
+}
+
+// Notifications method gets notification messages.
+func (req *Request) Notifications(ctx context.Context) ([]*Notification, error) {
+	return get[*Notification](ctx, req, TypeNotifications)
+}
+
+func get[T any](ctx context.Context, req *Request, typ EventType) ([]T, error) {
+	r, err := req.GetRawData(ctx, typ)
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	defer r.Close()
+	b, err := io.ReadAll(r)
+	if err != nil {
+		return nil, errs.Wrap(err, errs.WithContext("type", typ))
+	}
+	list := []T{}
+	if len(bytes.TrimSpace(b)) == 0 { // DONKI API returns empty body if no event
+		return list, nil
+	}
+	if err := json.Unmarshal(b, &list); err != nil {
+		return nil, errs.Wrap(err, errs.WithContext("type", typ))
+	}
+	return list, nil
+}
+
+func toEvents[T Event](list []T, err error) ([]Event, error) {
+	if err != nil {
+		return nil, err
+	}
+	events := make([]Event, 0, len(list))
+	for _, e := range list {
+		events = append(events, e)
+	}
+	return events, nil
+}
+
+func (req *Request) makeQuery(typ EventType) (url.Values, error) {
+	if !req.StartDate.IsZero() && !req.EndDate.IsZero() && req.EndDate.Before(req.StartDate.Time) {
+		return nil, errs.Wrap(ecode.ErrEndBeforeStart, errs.WithContext("config", req))
+	}
+	q := url.Values{}
+	if !req.StartDate.IsZero() {
+		q.Set("startDate", req.StartDate.Format(time.DateOnly))
+	}
+	if !req.EndDate.IsZero() {
+		q.Set("endDate", req.EndDate.Format(time.DateOnly))
+	}
+	switch typ {
+	case TypeCMEAnalysis:
+		if req.Speed > 0 {
+			q.Set("speed", strconv.FormatFloat(req.Speed, 'f', -1, 64))
+		}
+		if req.HalfAngle > 0 {
+			q.Set("halfAngle", strconv.FormatFloat(req.HalfAngle, 'f', -1, 64))
+		}
+		if len(req.Catalog) > 0 {
+			q.Set("catalog", req.Catalog)
+		}
+		if len(req.Keyword) > 0 {
+			q.Set("keyword", req.Keyword)
+		}
+	case TypeIPS:
+		if len(req.Location) > 0 {
+			q.Set("location", req.Location)
+		}
+		if len(req.Catalog) > 0 {
+			q.Set("catalog", req.Catalog)
+		}
+	case TypeNotifications:
+		if len(req.NotificationType) > 0 {
+			q.Set("type", req.NotificationType)
+		}
+	}
+	q.Set("api_key", nasaapi.APIKey(req.APIKey))
+	return q, nil
+}
+
+/* MIT License
+ *
+ * Copyright 2023 Spiegel
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
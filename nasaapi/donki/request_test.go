@@ -0,0 +1,112 @@
+package donki
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/goark/apod/ecode"
+	"github.com/goark/apod/internal/testutil"
+)
+
+func TestMakeQuery(t *testing.T) {
+	testCases := []struct {
+		req *Request
+		typ EventType
+		q   string
+		err error
+	}{
+		{req: New(), typ: TypeCME, q: "api_key=DEMO_KEY", err: nil},
+		{req: New(WithStartDate(testutil.DateFromMust(t, "2023-02-01")), WithEndDate(testutil.DateFromMust(t, "2023-02-28")), WithAPIKey("foo")), typ: TypeFLR, q: "api_key=foo&endDate=2023-02-28&startDate=2023-02-01", err: nil},
+		{req: New(WithSpeed(500), WithHalfAngle(30.5), WithCatalog("ALL"), WithKeyword("swpc_annex")), typ: TypeCMEAnalysis, q: "api_key=DEMO_KEY&catalog=ALL&halfAngle=30.5&keyword=swpc_annex&speed=500", err: nil},
+		{req: New(WithSpeed(500), WithLocation("Earth"), WithCatalog("ALL")), typ: TypeIPS, q: "api_key=DEMO_KEY&catalog=ALL&location=Earth", err: nil},
+		{req: New(WithNotificationType("FLR"), WithLocation("Earth")), typ: TypeNotifications, q: "api_key=DEMO_KEY&type=FLR", err: nil},
+		{req: New(WithStartDate(testutil.DateFromMust(t, "2023-02-28")), WithEndDate(testutil.DateFromMust(t, "2023-02-01"))), typ: TypeCME, err: ecode.ErrEndBeforeStart},
+	}
+
+	for _, tc := range testCases {
+		q, err := tc.req.makeQuery(tc.typ)
+		if !errors.Is(err, tc.err) {
+			t.Errorf("makeQuery() is \"%v\", want \"%v\"", err, tc.err)
+		} else if err == nil && q.Encode() != tc.q {
+			t.Errorf("makeQuery() is \"%v\", want \"%v\"", q.Encode(), tc.q)
+		}
+	}
+}
+
+func TestEventTypeFrom(t *testing.T) {
+	testCases := []struct {
+		s   string
+		typ EventType
+		err error
+	}{
+		{s: "cme", typ: TypeCME, err: nil},
+		{s: "wsaenlilsimulations", typ: TypeWSAEnlilSimulations, err: nil},
+		{s: "Notifications", typ: TypeNotifications, err: nil},
+		{s: "foo", typ: "", err: ecode.ErrInvalidParameter},
+	}
+
+	for _, tc := range testCases {
+		typ, err := EventTypeFrom(tc.s)
+		if !errors.Is(err, tc.err) {
+			t.Errorf("EventTypeFrom(%v) is \"%v\", want \"%v\"", tc.s, err, tc.err)
+		}
+		if typ != tc.typ {
+			t.Errorf("EventTypeFrom(%v) is \"%v\", want \"%v\"", tc.s, typ, tc.typ)
+		}
+	}
+}
+
+func TestEvents(t *testing.T) {
+	_, cli := testutil.NewServer(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case TypeFLR.Path():
+			_, _ = w.Write([]byte(`[{"flrID":"2023-02-17T19:38:00-FLR-001","beginTime":"2023-02-17T19:38Z","peakTime":"2023-02-17T20:16Z","endTime":null,"classType":"X2.2","sourceLocation":"N25E64","activeRegionNum":13226,"link":"https://example.com/FLR/1"}]`))
+		default: // no event
+		}
+	})
+	req := New(WithClient(cli))
+
+	events, err := req.Events(context.Background(), TypeFLR)
+	if err != nil {
+		t.Fatalf("Events() is \"%v\", want nil", err)
+	}
+	if len(events) != 1 {
+		t.Fatalf("Events() is %v, want 1 event", events)
+	}
+	s := events[0].Summary()
+	if s.ID != "2023-02-17T19:38:00-FLR-001" || s.Time.String() != "2023-02-17T20:16Z" || s.Detail != "class=X2.2 source=N25E64 region=13226" {
+		t.Errorf("Summary() is %+v", s)
+	}
+
+	events, err = req.Events(context.Background(), TypeGST)
+	if err != nil {
+		t.Errorf("Events() is \"%v\", want nil", err)
+	} else if len(events) != 0 {
+		t.Errorf("Events() is %v, want no event", events)
+	}
+}
+
+/* MIT License
+ *
+ * Copyright 2023 Spiegel
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
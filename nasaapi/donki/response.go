@@ -0,0 +1,323 @@
+package donki
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Instrument is instrument which observed the event.
+type Instrument struct {
+	DisplayName string `json:"displayName"`
+}
+
+// LinkedEvent is reference to linked event.
+type LinkedEvent struct {
+	ActivityID string `json:"activityID"`
+}
+
+// CME is Coronal Mass Ejection event.
+type CME struct {
+	ActivityID      string         `json:"activityID"`
+	Catalog         string         `json:"catalog"`
+	StartTime       Time           `json:"startTime"`
+	SourceLocation  string         `json:"sourceLocation"`
+	ActiveRegionNum int            `json:"activeRegionNum"`
+	Link            string         `json:"link"`
+	Note            string         `json:"note"`
+	Instruments     []*Instrument  `json:"instruments"`
+	CMEAnalyses     []*CMEAnalysis `json:"cmeAnalyses"`
+	LinkedEvents    []*LinkedEvent `json:"linkedEvents"`
+}
+
+// CMEAnalysis is analysis of Coronal Mass Ejection.
+type CMEAnalysis struct {
+	Time21_5              Time    `json:"time21_5"`
+	Latitude              float64 `json:"latitude"`
+	Longitude             float64 `json:"longitude"`
+	HalfAngle             float64 `json:"halfAngle"`
+	Speed                 float64 `json:"speed"`
+	Type                  string  `json:"type"`
+	IsMostAccurate        bool    `json:"isMostAccurate"`
+	AssociatedCMEID       string  `json:"associatedCMEID,omitempty"`
+	Note                  string  `json:"note"`
+	Catalog               string  `json:"catalog,omitempty"`
+	LevelOfData           int     `json:"levelOfData"`
+	Link                  string  `json:"link"`
+	SpeedMeasuredAtHeight float64 `json:"speedMeasuredAtHeight,omitempty"`
+}
+
+// GST is Geomagnetic Storm event.
+type GST struct {
+	GSTID        string         `json:"gstID"`
+	StartTime    Time           `json:"startTime"`
+	AllKpIndex   []*KpIndex     `json:"allKpIndex"`
+	Link         string         `json:"link"`
+	LinkedEvents []*LinkedEvent `json:"linkedEvents"`
+}
+
+// KpIndex is observed Kp index of geomagnetic storm.
+type KpIndex struct {
+	ObservedTime Time    `json:"observedTime"`
+	KpIndex      float64 `json:"kpIndex"`
+	Source       string  `json:"source"`
+}
+
+// FLR is Solar Flare event.
+type FLR struct {
+	FLRID           string         `json:"flrID"`
+	Instruments     []*Instrument  `json:"instruments"`
+	BeginTime       Time           `json:"beginTime"`
+	PeakTime        Time           `json:"peakTime"`
+	EndTime         Time           `json:"endTime"`
+	ClassType       string         `json:"classType"`
+	SourceLocation  string         `json:"sourceLocation"`
+	ActiveRegionNum int            `json:"activeRegionNum"`
+	Link            string         `json:"link"`
+	LinkedEvents    []*LinkedEvent `json:"linkedEvents"`
+}
+
+// SEP is Solar Energetic Particle event.
+type SEP struct {
+	SEPID        string         `json:"sepID"`
+	EventTime    Time           `json:"eventTime"`
+	Instruments  []*Instrument  `json:"instruments"`
+	Link         string         `json:"link"`
+	LinkedEvents []*LinkedEvent `json:"linkedEvents"`
+}
+
+// IPS is Interplanetary Shock event.
+type IPS struct {
+	Catalog      string         `json:"catalog"`
+	ActivityID   string         `json:"activityID"`
+	Location     string         `json:"location"`
+	EventTime    Time           `json:"eventTime"`
+	Link         string         `json:"link"`
+	Instruments  []*Instrument  `json:"instruments"`
+	LinkedEvents []*LinkedEvent `json:"linkedEvents"`
+}
+
+// MPC is Magnetopause Crossing event.
+type MPC struct {
+	MPCID        string         `json:"mpcID"`
+	EventTime    Time           `json:"eventTime"`
+	Instruments  []*Instrument  `json:"instruments"`
+	Link         string         `json:"link"`
+	LinkedEvents []*LinkedEvent `json:"linkedEvents"`
+}
+
+// RBE is Radiation Belt Enhancement event.
+type RBE struct {
+	RBEID        string         `json:"rbeID"`
+	EventTime    Time           `json:"eventTime"`
+	Instruments  []*Instrument  `json:"instruments"`
+	Link         string         `json:"link"`
+	LinkedEvents []*LinkedEvent `json:"linkedEvents"`
+}
+
+// HSS is High Speed Stream event.
+type HSS struct {
+	HSSID        string         `json:"hssID"`
+	EventTime    Time           `json:"eventTime"`
+	Instruments  []*Instrument  `json:"instruments"`
+	Link         string         `json:"link"`
+	LinkedEvents []*LinkedEvent `json:"linkedEvents"`
+}
+
+// WSAEnlilSimulation is result of WSA+Enlil simulation.
+type WSAEnlilSimulation struct {
+	SimulationID              string      `json:"simulationID"`
+	ModelCompletionTime       Time        `json:"modelCompletionTime"`
+	AU                        float64     `json:"au"`
+	EstimatedShockArrivalTime Time        `json:"estimatedShockArrivalTime"`
+	EstimatedDuration         float64     `json:"estimatedDuration"`
+	RminRe                    float64     `json:"rmin_re"`
+	Kp18                      float64     `json:"kp_18"`
+	Kp90                      float64     `json:"kp_90"`
+	Kp135                     float64     `json:"kp_135"`
+	Kp180                     float64     `json:"kp_180"`
+	IsEarthGB                 bool        `json:"isEarthGB"`
+	Link                      string      `json:"link"`
+	ImpactList                []*Impact   `json:"impactList"`
+	CMEInputs                 []*CMEInput `json:"cmeInputs"`
+}
+
+// CMEInput is input CME of WSA+Enlil simulation.
+type CMEInput struct {
+	CMEID          string  `json:"cmeid"`
+	CMEStartTime   Time    `json:"cmeStartTime"`
+	Latitude       float64 `json:"latitude"`
+	Longitude      float64 `json:"longitude"`
+	Speed          float64 `json:"speed"`
+	HalfAngle      float64 `json:"halfAngle"`
+	Time21_5       Time    `json:"time21_5"`
+	IsMostAccurate bool    `json:"isMostAccurate"`
+	LevelOfData    int     `json:"levelOfData"`
+}
+
+// Impact is predicted impact of WSA+Enlil simulation.
+type Impact struct {
+	IsGlancingBlow bool   `json:"isGlancingBlow"`
+	Location       string `json:"location"`
+	ArrivalTime    Time   `json:"arrivalTime"`
+}
+
+// Notification is notification message of space weather.
+type Notification struct {
+	MessageType      string `json:"messageType"`
+	MessageID        string `json:"messageID"`
+	MessageURL       string `json:"messageURL"`
+	MessageIssueTime Time   `json:"messageIssueTime"`
+	MessageBody      string `json:"messageBody"`
+}
+
+// Summary is common summary of DONKI events (for table output).
+type Summary struct {
+	Type   EventType `json:"type"`
+	ID     string    `json:"id"`
+	Time   Time      `json:"time"`
+	Detail string    `json:"detail"`
+	Link   string    `json:"link"`
+}
+
+// Event is interface for DONKI events.
+type Event interface {
+	Summary() *Summary
+}
+
+// Summary method returns summary of CME event.
+func (e *CME) Summary() *Summary {
+	detail := []string{}
+	if len(e.SourceLocation) > 0 {
+		detail = append(detail, "source="+e.SourceLocation)
+	}
+	for _, a := range e.CMEAnalyses {
+		if a != nil && a.IsMostAccurate {
+			detail = append(detail, a.detail()...)
+			break
+		}
+	}
+	return &Summary{Type: TypeCME, ID: e.ActivityID, Time: e.StartTime, Detail: strings.Join(detail, " "), Link: e.Link}
+}
+
+// Summary method returns summary of CME analysis.
+func (e *CMEAnalysis) Summary() *Summary {
+	return &Summary{Type: TypeCMEAnalysis, ID: e.AssociatedCMEID, Time: e.Time21_5, Detail: strings.Join(e.detail(), " "), Link: e.Link}
+}
+
+func (e *CMEAnalysis) detail() []string {
+	return []string{
+		"speed=" + formatFloat(e.Speed) + "km/s",
+		"halfAngle=" + formatFloat(e.HalfAngle),
+		"type=" + e.Type,
+	}
+}
+
+// Summary method returns summary of GST event.
+func (e *GST) Summary() *Summary {
+	kp := 0.0
+	for _, k := range e.AllKpIndex {
+		if k != nil && k.KpIndex > kp {
+			kp = k.KpIndex
+		}
+	}
+	return &Summary{Type: TypeGST, ID: e.GSTID, Time: e.StartTime, Detail: "maxKp=" + formatFloat(kp), Link: e.Link}
+}
+
+// Summary method returns summary of FLR event.
+func (e *FLR) Summary() *Summary {
+	detail := []string{"class=" + e.ClassType}
+	if len(e.SourceLocation) > 0 {
+		detail = append(detail, "source="+e.SourceLocation)
+	}
+	if e.ActiveRegionNum > 0 {
+		detail = append(detail, "region="+strconv.Itoa(e.ActiveRegionNum))
+	}
+	return &Summary{Type: TypeFLR, ID: e.FLRID, Time: e.PeakTime, Detail: strings.Join(detail, " "), Link: e.Link}
+}
+
+// Summary method returns summary of SEP event.
+func (e *SEP) Summary() *Summary {
+	return &Summary{Type: TypeSEP, ID: e.SEPID, Time: e.EventTime, Detail: instruments(e.Instruments), Link: e.Link}
+}
+
+// Summary method returns summary of IPS event.
+func (e *IPS) Summary() *Summary {
+	return &Summary{Type: TypeIPS, ID: e.ActivityID, Time: e.EventTime, Detail: "location=" + e.Location, Link: e.Link}
+}
+
+// Summary method returns summary of MPC event.
+func (e *MPC) Summary() *Summary {
+	return &Summary{Type: TypeMPC, ID: e.MPCID, Time: e.EventTime, Detail: instruments(e.Instruments), Link: e.Link}
+}
+
+// Summary method returns summary of RBE event.
+func (e *RBE) Summary() *Summary {
+	return &Summary{Type: TypeRBE, ID: e.RBEID, Time: e.EventTime, Detail: instruments(e.Instruments), Link: e.Link}
+}
+
+// Summary method returns summary of HSS event.
+func (e *HSS) Summary() *Summary {
+	return &Summary{Type: TypeHSS, ID: e.HSSID, Time: e.EventTime, Detail: instruments(e.Instruments), Link: e.Link}
+}
+
+// Summary method returns summary of WSA+Enlil simulation.
+func (e *WSAEnlilSimulation) Summary() *Summary {
+	detail := []string{}
+	if !e.EstimatedShockArrivalTime.IsZero() {
+		detail = append(detail, "shockArrival="+e.EstimatedShockArrivalTime.String())
+	}
+	if e.IsEarthGB {
+		detail = append(detail, "earthGlancingBlow")
+	}
+	ids := make([]string, 0, len(e.CMEInputs))
+	for _, c := range e.CMEInputs {
+		if c != nil {
+			ids = append(ids, c.CMEID)
+		}
+	}
+	detail = append(detail, "cme="+strings.Join(ids, ","))
+	return &Summary{Type: TypeWSAEnlilSimulations, ID: e.SimulationID, Time: e.ModelCompletionTime, Detail: strings.Join(detail, " "), Link: e.Link}
+}
+
+// Summary method returns summary of notification.
+func (e *Notification) Summary() *Summary {
+	return &Summary{Type: TypeNotifications, ID: e.MessageID, Time: e.MessageIssueTime, Detail: "messageType=" + e.MessageType, Link: e.MessageURL}
+}
+
+func instruments(list []*Instrument) string {
+	names := make([]string, 0, len(list))
+	for _, i := range list {
+		if i != nil {
+			names = append(names, i.DisplayName)
+		}
+	}
+	return fmt.Sprintf("instruments=%s", strings.Join(names, ","))
+}
+
+func formatFloat(f float64) string {
+	return strconv.FormatFloat(f, 'f', -1, 64)
+}
+
+/* MIT License
+ *
+ * Copyright 2023 Spiegel
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
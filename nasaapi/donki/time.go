@@ -0,0 +1,87 @@
+package donki
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/goark/errs"
+)
+
+// Time is wrapper class of time.Time for DONKI API (e.g. "2023-02-24T12:34Z").
+type Time struct {
+	time.Time
+}
+
+var timeTemplate = []string{
+	"2006-01-02T15:04Z",
+	"2006-01-02T15:04:05Z",
+	"2006-01-02T15:04:05.000Z",
+	time.RFC3339,
+	time.DateOnly,
+}
+
+// TimeFrom returns Time instance from time string.
+func TimeFrom(s string) (Time, error) {
+	if len(s) == 0 || strings.EqualFold(s, "null") {
+		return Time{}, nil
+	}
+	var lastErr error
+	for _, tmplt := range timeTemplate {
+		if tm, err := time.Parse(tmplt, s); err != nil {
+			lastErr = errs.Wrap(err, errs.WithContext("time_string", s), errs.WithContext("time_template", tmplt))
+		} else {
+			return Time{tm}, nil
+		}
+	}
+	return Time{}, lastErr
+}
+
+// Stringer with "2006-01-02T15:04Z" format (UTC).
+func (t Time) String() string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.UTC().Format(timeTemplate[0])
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (t Time) MarshalJSON() ([]byte, error) {
+	if t.IsZero() {
+		return []byte(`""`), nil
+	}
+	return []byte(strconv.Quote(t.UTC().Format(time.RFC3339))), nil
+}
+
+// UnmarshalJSON implements the json.UnmarshalJSON interface.
+func (t *Time) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		s = string(b)
+	}
+	*t, err = TimeFrom(s)
+	return err
+}
+
+/* MIT License
+ *
+ * Copyright 2023 Spiegel
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
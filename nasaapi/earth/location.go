@@ -0,0 +1,148 @@
+package earth
+
+import (
+	"encoding/csv"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/goark/apod/ecode"
+	"github.com/goark/apod/nasaapi"
+	"github.com/goark/errs"
+)
+
+// Location is a point for Earth imagery.
+type Location struct {
+	Name string       `json:"name,omitempty"` // name of the location (optional)
+	Lat  float64      `json:"lat"`            // Latitude
+	Lon  float64      `json:"lon"`            // Longitude
+	Dim  float64      `json:"dim,omitempty"`  // width and height of image in degrees (optional)
+	Date nasaapi.Date `json:"date,omitempty"` // date of image (optional)
+}
+
+// Opts method returns option functions of Request for the location.
+// Dim and Date are set only if they are not zero.
+func (l *Location) Opts() []Opts {
+	if l == nil {
+		return nil
+	}
+	opts := []Opts{WithLocation(l.Lat, l.Lon)}
+	if l.Dim > 0 {
+		opts = append(opts, WithDim(l.Dim))
+	}
+	if !l.Date.IsZero() {
+		opts = append(opts, WithDate(l.Date))
+	}
+	return opts
+}
+
+// String is Stringer method. Returns Name, or "<lat>_<lon>" if Name is empty.
+func (l *Location) String() string {
+	if l == nil {
+		return ""
+	}
+	if len(l.Name) > 0 {
+		return l.Name
+	}
+	return strconv.FormatFloat(l.Lat, 'f', -1, 64) + "_" + strconv.FormatFloat(l.Lon, 'f', -1, 64)
+}
+
+var csvColumns = []string{"lat", "lon", "date", "dim", "name"}
+
+// ReadLocations function reads list of locations from CSV data.
+// Columns are lat, lon, date, dim and name (date, dim and name are optional).
+// If first record is header (e.g. "name,lat,lon"), columns are in the order of the header.
+// Empty lines and lines starting with '#' are ignored. Date is any expression of nasaapi.ParseDate function.
+func ReadLocations(r io.Reader) ([]*Location, error) {
+	cr := csv.NewReader(r)
+	cr.Comment = '#'
+	cr.FieldsPerRecord = -1
+	cr.TrimLeadingSpace = true
+	records, err := cr.ReadAll()
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	columns := csvColumns
+	if len(records) > 0 && isHeader(records[0]) {
+		columns = make([]string, len(records[0]))
+		for i, name := range records[0] {
+			columns[i] = strings.ToLower(strings.TrimSpace(name))
+		}
+		records = records[1:]
+	}
+	locations := make([]*Location, 0, len(records))
+	for i, record := range records {
+		loc, err := parseLocation(columns, record)
+		if err != nil {
+			return nil, errs.Wrap(err, errs.WithContext("record", i+1))
+		}
+		locations = append(locations, loc)
+	}
+	return locations, nil
+}
+
+func isHeader(record []string) bool {
+	for _, field := range record {
+		name := strings.ToLower(strings.TrimSpace(field))
+		if name == "lat" || name == "lon" {
+			return true
+		}
+	}
+	return false
+}
+
+func parseLocation(columns, record []string) (*Location, error) {
+	loc := &Location{}
+	var latFlag, lonFlag bool
+	for i, field := range record {
+		field = strings.TrimSpace(field)
+		if i >= len(columns) || len(field) == 0 {
+			continue
+		}
+		var err error
+		switch columns[i] {
+		case "lat":
+			loc.Lat, err = strconv.ParseFloat(field, 64)
+			latFlag = true
+		case "lon":
+			loc.Lon, err = strconv.ParseFloat(field, 64)
+			lonFlag = true
+		case "dim":
+			loc.Dim, err = strconv.ParseFloat(field, 64)
+		case "date":
+			loc.Date, err = nasaapi.ParseDate(field)
+		case "name":
+			loc.Name = field
+		}
+		if err != nil {
+			return nil, errs.Wrap(ecode.ErrInvalidParameter, errs.WithCause(err), errs.WithContext(columns[i], field))
+		}
+	}
+	if !latFlag || !lonFlag {
+		return nil, errs.Wrap(ecode.ErrInvalidParameter, errs.WithContext("record", strings.Join(record, ",")))
+	}
+	return loc, nil
+}
+
+/* MIT License
+ *
+ * Copyright 2023 Spiegel
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
@@ -0,0 +1,183 @@
+package earth
+
+import (
+	"context"
+	"io"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/goark/apod/ecode"
+	"github.com/goark/apod/nasaapi"
+	"github.com/goark/errs"
+)
+
+const (
+	ImageryPath = "/planetary/earth/imagery" // path of Earth imagery API (PNG image)
+	AssetsPath  = "/planetary/earth/assets"  // path of Earth assets API (metadata of images)
+)
+
+// Request is for context of Earth API.
+type Request struct {
+	Lat    float64      `json:"lat"`            // Latitude
+	Lon    float64      `json:"lon"`            // Longitude
+	Dim    float64      `json:"dim,omitempty"`  // width and height of image in degrees (default is 0.025)
+	Date   nasaapi.Date `json:"date,omitempty"` // date of image; the closest image to the date is returned (default is today)
+	APIKey string       `json:"api_key"`        // api.nasa.gov key for expanded usage
+	client *nasaapi.Client
+}
+
+type Opts func(*Request)
+
+// New returns new Request instance for Earth API.
+func New(opts ...Opts) *Request {
+	ctx := &Request{}
+	for _, opt := range opts {
+		opt(ctx)
+	}
+	return ctx
+}
+
+// WithLocation returns function for setting Request.Lat and Request.Lon.
+func WithLocation(lat, lon float64) Opts {
+	return func(ctx *Request) {
+		if ctx != nil {
+			ctx.Lat = lat
+			ctx.Lon = lon
+		}
+	}
+}
+
+// WithDim returns function for setting Request.Dim.
+func WithDim(dim float64) Opts {
+	return func(ctx *Request) {
+		if ctx != nil {
+			ctx.Dim = dim
+		}
+	}
+}
+
+// WithDate returns function for setting Request.Date.
+func WithDate(date nasaapi.Date) Opts {
+	return func(ctx *Request) {
+		if ctx != nil {
+			ctx.Date = date
+		}
+	}
+}
+
+// WithAPIKey returns function for setting Request.APIKey.
+func WithAPIKey(apiKey string) Opts {
+	return func(ctx *Request) {
+		if ctx != nil {
+			ctx.APIKey = apiKey
+		}
+	}
+}
+
+// WithClient returns function for setting nasaapi.Client.
+func WithClient(client *nasaapi.Client) Opts {
+	return func(ctx *Request) {
+		if ctx != nil {
+			ctx.client = client
+		}
+	}
+}
+
+// Client method returns nasaapi.Client instance for requesting. If not set, returns nasaapi.DefaultClient().
+func (req *Request) Client() *nasaapi.Client {
+	if req == nil || req.client == nil {
+		return nasaapi.DefaultClient()
+	}
+	return req.client
+}
+
+// Assets method gets metadata of images (assets) for the location, closest to Request.Date.
+func (req *Request) Assets(ctx context.Context) ([]*Asset, error) {
+	if req == nil {
+		return nil, errs.Wrap(ecode.ErrNullPointer)
+	}
+	q, err := req.query()
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	var resp assetsResponse
+	if err := req.Client().RequestJSON(ctx, AssetsPath, q, &resp); err != nil {
+		return nil, errs.Wrap(err, errs.WithContext("lat", req.Lat), errs.WithContext("lon", req.Lon), errs.WithContext("date", req.Date))
+	}
+	return resp.Assets, nil
+}
+
+// ImageryURL method returns URL of PNG image for the location.
+func (req *Request) ImageryURL() (*url.URL, error) {
+	if req == nil {
+		return nil, errs.Wrap(ecode.ErrNullPointer)
+	}
+	q, err := req.query()
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return req.Client().URL(ImageryPath, q), nil
+}
+
+// Imagery method gets PNG image for the location.
+func (req *Request) Imagery(ctx context.Context) (io.ReadCloser, error) {
+	if req == nil {
+		return nil, errs.Wrap(ecode.ErrNullPointer)
+	}
+	q, err := req.query()
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	r, err := req.Client().Request(ctx, ImageryPath, q)
+	if err != nil {
+		return nil, errs.Wrap(err, errs.WithContext("lat", req.Lat), errs.WithContext("lon", req.Lon), errs.WithContext("date", req.Date))
+	}
+	return r, nil
+}
+
+func (req *Request) query() (url.Values, error) {
+	if req.Lat < -90 || req.Lat > 90 {
+		return nil, errs.Wrap(ecode.ErrInvalidParameter, errs.WithContext("lat", req.Lat))
+	}
+	if req.Lon < -180 || req.Lon > 180 {
+		return nil, errs.Wrap(ecode.ErrInvalidParameter, errs.WithContext("lon", req.Lon))
+	}
+	if req.Dim < 0 {
+		return nil, errs.Wrap(ecode.ErrInvalidParameter, errs.WithContext("dim", req.Dim))
+	}
+	q := url.Values{}
+	q.Set("lat", strconv.FormatFloat(req.Lat, 'f', -1, 64))
+	q.Set("lon", strconv.FormatFloat(req.Lon, 'f', -1, 64))
+	if req.Dim > 0 {
+		q.Set("dim", strconv.FormatFloat(req.Dim, 'f', -1, 64))
+	}
+	if !req.Date.IsZero() {
+		q.Set("date", req.Date.Format(time.DateOnly))
+	}
+	q.Set("api_key", nasaapi.APIKey(req.APIKey))
+	return q, nil
+}
+
+/* MIT License
+ *
+ * Copyright 2023 Spiegel
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
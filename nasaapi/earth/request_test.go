@@ -0,0 +1,168 @@
+package earth
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/goark/apod/ecode"
+	"github.com/goark/apod/internal/testutil"
+)
+
+func TestAssets(t *testing.T) {
+	var query string
+	_, cli := testutil.NewServer(t, func(w http.ResponseWriter, r *http.Request) {
+		query = r.URL.RawQuery
+		switch {
+		case r.URL.Query().Get("lat") == "0":
+			http.Error(w, `{"msg":"No imagery for specified date."}`, http.StatusNotFound)
+		case r.URL.Path == AssetsPath && r.URL.Query().Get("lat") == "1.5":
+			_, _ = w.Write([]byte(`{"date":"2014-02-04T03:30:01.210000","id":"LC8_L1T_TOA/LC81270592014035LGN00","resource":{"dataset":"LANDSAT/LC08/C01/T1_SR","planet":"earth"},"service_version":"v5000"}`))
+		case r.URL.Path == AssetsPath:
+			_, _ = w.Write([]byte(`{"count":2,"results":[{"date":"2014-01-01T03:30:01","id":"a"},{"date":"2014-01-17T03:30:01","id":"b"}]}`))
+		case r.URL.Path == ImageryPath:
+			w.Header().Set("Content-Type", "image/png")
+			_, _ = w.Write([]byte("PNG"))
+		default:
+			http.NotFound(w, r)
+		}
+	})
+
+	testCases := []struct {
+		req   *Request
+		query string
+		ids   []string
+		date  string
+		err   error
+	}{
+		{req: New(WithClient(cli), WithLocation(1.5, 100.75), WithDate(testutil.DateFromMust(t, "2014-02-01"))), query: "api_key=DEMO_KEY&date=2014-02-01&lat=1.5&lon=100.75", ids: []string{"LC8_L1T_TOA/LC81270592014035LGN00"}, date: "2014-02-04T03:30:01.210000", err: nil},
+		{req: New(WithClient(cli), WithLocation(29.78, -95.33), WithDim(0.1), WithAPIKey("foo")), query: "api_key=foo&dim=0.1&lat=29.78&lon=-95.33", ids: []string{"a", "b"}, date: "2014-01-01T03:30:01.000000", err: nil},
+		{req: New(WithClient(cli), WithLocation(0, 0)), query: "api_key=DEMO_KEY&lat=0&lon=0", err: ecode.ErrNotFound},
+		{req: New(WithClient(cli), WithLocation(91, 0)), query: "", err: ecode.ErrInvalidParameter},
+	}
+
+	for _, tc := range testCases {
+		query = ""
+		assets, err := tc.req.Assets(context.Background())
+		if query != tc.query {
+			t.Errorf("query of Assets() is \"%v\", want \"%v\"", query, tc.query)
+		}
+		if !errors.Is(err, tc.err) {
+			t.Errorf("Assets() is \"%v\", want \"%v\"", err, tc.err)
+		}
+		if err != nil {
+			continue
+		}
+		if len(assets) != len(tc.ids) {
+			t.Errorf("Assets() is %v, want %v", assets, tc.ids)
+			continue
+		}
+		for i, a := range assets {
+			if a.ID != tc.ids[i] {
+				t.Errorf("Assets()[%d].ID is \"%v\", want \"%v\"", i, a.ID, tc.ids[i])
+			}
+		}
+		if assets[0].Date.String() != tc.date {
+			t.Errorf("Assets()[0].Date is \"%v\", want \"%v\"", assets[0].Date, tc.date)
+		}
+	}
+
+	r, err := New(WithClient(cli), WithLocation(1.5, 100.75)).Imagery(context.Background())
+	if err != nil {
+		t.Fatalf("Imagery() is \"%v\", want nil", err)
+	}
+	defer r.Close()
+	if b, _ := io.ReadAll(r); string(b) != "PNG" {
+		t.Errorf("Imagery() is \"%v\", want \"PNG\"", string(b))
+	}
+	if _, err := New(WithClient(cli), WithLocation(0, 0)).Imagery(context.Background()); !errors.Is(err, ecode.ErrNotFound) {
+		t.Errorf("Imagery() is \"%v\", want \"%v\"", err, ecode.ErrNotFound)
+	}
+}
+
+func TestImageryURL(t *testing.T) {
+	testCases := []struct {
+		req *Request
+		url string
+		err error
+	}{
+		{req: New(WithLocation(1.5, 100.75), WithDim(0.15), WithDate(testutil.DateFromMust(t, "2014-02-01")), WithAPIKey("foo")), url: "https://api.nasa.gov/planetary/earth/imagery?api_key=foo&date=2014-02-01&dim=0.15&lat=1.5&lon=100.75"},
+		{req: New(WithLocation(-33.9, 151.2)), url: "https://api.nasa.gov/planetary/earth/imagery?api_key=DEMO_KEY&lat=-33.9&lon=151.2"},
+		{req: New(WithLocation(91, 0)), err: ecode.ErrInvalidParameter},
+		{req: New(WithLocation(0, -181)), err: ecode.ErrInvalidParameter},
+		{req: New(WithDim(-1)), err: ecode.ErrInvalidParameter},
+	}
+
+	for _, tc := range testCases {
+		u, err := tc.req.ImageryURL()
+		if !errors.Is(err, tc.err) {
+			t.Errorf("ImageryURL() error is \"%v\", want \"%v\"", err, tc.err)
+		} else if err == nil && u.String() != tc.url {
+			t.Errorf("ImageryURL() is \"%v\", want \"%v\"", u, tc.url)
+		}
+	}
+}
+
+func TestReadLocations(t *testing.T) {
+	testCases := []struct {
+		csv   string
+		names []string
+		err   error
+	}{
+		{csv: "1.5,100.75\n# comment\n\n29.78,-95.33,2018-01-01,0.1,Houston\n", names: []string{"1.5_100.75", "Houston"}},
+		{csv: "name,lon,lat,date\nTokyo,139.77,35.68,2019-05-30\nOsaka,135.5,34.69,\n", names: []string{"Tokyo", "Osaka"}},
+		{csv: "1.5\n", err: ecode.ErrInvalidParameter},
+		{csv: "1.5,foo\n", err: ecode.ErrInvalidParameter},
+		{csv: "1.5,100.75,2018-13-01\n", err: ecode.ErrInvalidParameter},
+	}
+
+	for _, tc := range testCases {
+		list, err := ReadLocations(strings.NewReader(tc.csv))
+		if !errors.Is(err, tc.err) {
+			t.Errorf("ReadLocations(%q) error is \"%v\", want \"%v\"", tc.csv, err, tc.err)
+			continue
+		}
+		if err != nil {
+			continue
+		}
+		if len(list) != len(tc.names) {
+			t.Errorf("ReadLocations(%q) is %v, want %v", tc.csv, list, tc.names)
+			continue
+		}
+		for i, loc := range list {
+			if loc.String() != tc.names[i] {
+				t.Errorf("ReadLocations(%q)[%d] is \"%v\", want \"%v\"", tc.csv, i, loc, tc.names[i])
+			}
+		}
+	}
+	list, _ := ReadLocations(strings.NewReader("name,lon,lat,date\nTokyo,139.77,35.68,2019-05-30\n"))
+	if loc := list[0]; loc.Lat != 35.68 || loc.Lon != 139.77 || loc.Date.String() != "2019-05-30" {
+		t.Errorf("ReadLocations() is %+v", loc)
+	}
+}
+
+/* MIT License
+ *
+ * Copyright 2023 Spiegel
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
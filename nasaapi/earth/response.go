@@ -0,0 +1,126 @@
+package earth
+
+import (
+	"encoding/json"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/goark/errs"
+)
+
+// Asset is metadata of Landsat 8 image (asset) for the location.
+type Asset struct {
+	ID             string    `json:"id"`
+	Date           DateTime  `json:"date"`
+	Resource       *Resource `json:"resource,omitempty"`
+	ServiceVersion string    `json:"service_version,omitempty"`
+	URL            string    `json:"url,omitempty"`
+}
+
+// Resource is source dataset of asset.
+type Resource struct {
+	Dataset string `json:"dataset"`
+	Planet  string `json:"planet"`
+}
+
+// assetsResponse is response of Earth assets API.
+// Earth API returns one asset closest to the date, or (older versions) list of assets in "results" element.
+type assetsResponse struct {
+	Assets []*Asset
+}
+
+// UnmarshalJSON implements the json.UnmarshalJSON interface.
+func (r *assetsResponse) UnmarshalJSON(b []byte) error {
+	var v struct {
+		Results []*Asset `json:"results"`
+		Asset
+	}
+	if err := json.Unmarshal(b, &v); err != nil {
+		return errs.Wrap(err)
+	}
+	switch {
+	case v.Results != nil:
+		r.Assets = v.Results
+	case len(v.ID) > 0 || !v.Date.IsZero():
+		r.Assets = []*Asset{&v.Asset}
+	default:
+		r.Assets = []*Asset{}
+	}
+	return nil
+}
+
+// DateTime is wrapper class of time.Time for Earth API (e.g. "2014-02-04T03:30:01.210000", in UTC).
+type DateTime struct {
+	time.Time
+}
+
+const dateTimeLayout = "2006-01-02T15:04:05.000000"
+
+var dateTimeLayouts = []string{
+	"2006-01-02T15:04:05.999999",
+	"2006-01-02T15:04:05.999999Z07:00",
+	"2006-01-02",
+}
+
+// DateTimeFrom returns DateTime instance from string.
+func DateTimeFrom(s string) (DateTime, error) {
+	if len(s) == 0 || strings.EqualFold(s, "null") {
+		return DateTime{}, nil
+	}
+	var lastErr error
+	for _, layout := range dateTimeLayouts {
+		tm, err := time.Parse(layout, s)
+		if err == nil {
+			return DateTime{Time: tm.UTC()}, nil
+		}
+		lastErr = err
+	}
+	return DateTime{}, errs.Wrap(lastErr, errs.WithContext("time_string", s))
+}
+
+// Stringer with "2006-01-02T15:04:05.000000" format.
+func (t DateTime) String() string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.Format(dateTimeLayout)
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (t DateTime) MarshalJSON() ([]byte, error) {
+	return []byte(strconv.Quote(t.String())), nil
+}
+
+// UnmarshalJSON implements the json.UnmarshalJSON interface.
+func (t *DateTime) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		s = string(b)
+	}
+	*t, err = DateTimeFrom(s)
+	return err
+}
+
+/* MIT License
+ *
+ * Copyright 2023 Spiegel
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
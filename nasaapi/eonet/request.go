@@ -0,0 +1,362 @@
+package eonet
+
+import (
+	"context"
+	"net/url"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/goark/apod/ecode"
+	"github.com/goark/apod/nasaapi"
+	"github.com/goark/errs"
+)
+
+const (
+	DefaultBaseURL = "https://eonet.gsfc.nasa.gov" // Default base URL of EONET API
+
+	EventsPath     = "/api/v3/events"
+	CategoriesPath = "/api/v3/categories"
+	SourcesPath    = "/api/v3/sources"
+	LayersPath     = "/api/v3/layers"
+)
+
+// Status is status of events.
+type Status string
+
+const (
+	StatusOpen   Status = "open"
+	StatusClosed Status = "closed"
+	StatusAll    Status = "all"
+)
+
+// StatusFrom returns Status from string (case insensitive). Empty string is not set.
+func StatusFrom(s string) (Status, error) {
+	switch st := Status(strings.ToLower(s)); st {
+	case "", StatusOpen, StatusClosed, StatusAll:
+		return st, nil
+	default:
+		return "", errs.Wrap(ecode.ErrInvalidParameter, errs.WithContext("status", s))
+	}
+}
+
+// BBox is bounding box of events.
+type BBox struct {
+	MinLon float64
+	MaxLat float64
+	MaxLon float64
+	MinLat float64
+}
+
+// ParseBBox returns BBox from "min_lon,max_lat,max_lon,min_lat" string (upper left and lower right corners).
+func ParseBBox(s string) (*BBox, error) {
+	if len(s) == 0 {
+		return nil, nil
+	}
+	elms := strings.Split(s, ",")
+	if len(elms) != 4 {
+		return nil, errs.Wrap(ecode.ErrInvalidParameter, errs.WithContext("bbox", s))
+	}
+	var v [4]float64
+	for i, elm := range elms {
+		f, err := strconv.ParseFloat(strings.TrimSpace(elm), 64)
+		if err != nil {
+			return nil, errs.Wrap(ecode.ErrInvalidParameter, errs.WithCause(err), errs.WithContext("bbox", s))
+		}
+		v[i] = f
+	}
+	bbox := &BBox{MinLon: v[0], MaxLat: v[1], MaxLon: v[2], MinLat: v[3]}
+	if bbox.MinLon < -180 || bbox.MaxLon > 180 || bbox.MinLat < -90 || bbox.MaxLat > 90 || bbox.MinLon > bbox.MaxLon || bbox.MinLat > bbox.MaxLat {
+		return nil, errs.Wrap(ecode.ErrInvalidParameter, errs.WithContext("bbox", s))
+	}
+	return bbox, nil
+}
+
+// String is Stringer method ("min_lon,max_lat,max_lon,min_lat" format).
+func (b *BBox) String() string {
+	if b == nil {
+		return ""
+	}
+	return strings.Join([]string{
+		strconv.FormatFloat(b.MinLon, 'f', -1, 64),
+		strconv.FormatFloat(b.MaxLat, 'f', -1, 64),
+		strconv.FormatFloat(b.MaxLon, 'f', -1, 64),
+		strconv.FormatFloat(b.MinLat, 'f', -1, 64),
+	}, ",")
+}
+
+// Request is for context of EONET (Earth Observatory Natural Event Tracker) API v3.
+type Request struct {
+	Status   Status       `json:"status,omitempty"`   // Status of events (default is open)
+	Category []string     `json:"category,omitempty"` // Category IDs (e.g. wildfires, severeStorms)
+	Source   []string     `json:"source,omitempty"`   // Source IDs (e.g. InciWeb, EO)
+	BBox     *BBox        `json:"bbox,omitempty"`     // Bounding box
+	Days     int          `json:"days,omitempty"`     // Number of prior days
+	Limit    int          `json:"limit,omitempty"`    // Maximum number of events
+	Start    nasaapi.Date `json:"start,omitempty"`    // Start date
+	End      nasaapi.Date `json:"end,omitempty"`      // End date
+	baseURL  *url.URL
+	client   *nasaapi.Client
+	cli      *nasaapi.Client // client for base URL (made by New function)
+}
+
+type Opts func(*Request)
+
+// New returns new Request instance for EONET API.
+func New(opts ...Opts) *Request {
+	ctx := &Request{}
+	for _, opt := range opts {
+		opt(ctx)
+	}
+	ctx.cli = ctx.newClient()
+	return ctx
+}
+
+// WithStatus returns function for setting Request.Status.
+func WithStatus(status Status) Opts {
+	return func(ctx *Request) {
+		if ctx != nil {
+			ctx.Status = status
+		}
+	}
+}
+
+// WithCategory returns function for setting Request.Category.
+func WithCategory(categories ...string) Opts {
+	return func(ctx *Request) {
+		if ctx != nil {
+			ctx.Category = categories
+		}
+	}
+}
+
+// WithSource returns function for setting Request.Source.
+func WithSource(sources ...string) Opts {
+	return func(ctx *Request) {
+		if ctx != nil {
+			ctx.Source = sources
+		}
+	}
+}
+
+// WithBBox returns function for setting Request.BBox.
+func WithBBox(bbox *BBox) Opts {
+	return func(ctx *Request) {
+		if ctx != nil {
+			ctx.BBox = bbox
+		}
+	}
+}
+
+// WithDays returns function for setting Request.Days.
+func WithDays(days int) Opts {
+	return func(ctx *Request) {
+		if ctx != nil {
+			ctx.Days = days
+		}
+	}
+}
+
+// WithLimit returns function for setting Request.Limit.
+func WithLimit(limit int) Opts {
+	return func(ctx *Request) {
+		if ctx != nil {
+			ctx.Limit = limit
+		}
+	}
+}
+
+// WithDateRange returns function for setting Request.Start and Request.End.
+func WithDateRange(start, end nasaapi.Date) Opts {
+	return func(ctx *Request) {
+		if ctx != nil {
+			ctx.Start = start
+			ctx.End = end
+		}
+	}
+}
+
+// WithBaseURL returns function for setting base URL of EONET API.
+func WithBaseURL(u *url.URL) Opts {
+	return func(ctx *Request) {
+		if ctx != nil {
+			ctx.baseURL = u
+		}
+	}
+}
+
+// WithClient returns function for setting nasaapi.Client.
+// Base URL of the client is replaced by DefaultBaseURL (or WithBaseURL option).
+func WithClient(client *nasaapi.Client) Opts {
+	return func(ctx *Request) {
+		if ctx != nil {
+			ctx.client = client
+		}
+	}
+}
+
+// Client method returns nasaapi.Client instance for requesting EONET API.
+// It returns the same instance for each call, so rate limit information of the last request is available.
+func (req *Request) Client() *nasaapi.Client {
+	if req != nil && req.cli != nil {
+		return req.cli
+	}
+	return req.newClient()
+}
+
+func (req *Request) newClient() *nasaapi.Client {
+	var cli *nasaapi.Client
+	if req != nil && req.client != nil {
+		cli = req.client
+	} else {
+		cli = nasaapi.DefaultClient()
+	}
+	return cli.Clone(nasaapi.WithBaseURL(req.BaseURL()))
+}
+
+// BaseURL method returns base URL of EONET API.
+func (req *Request) BaseURL() *url.URL {
+	if req == nil || req.baseURL == nil {
+		u, _ := url.Parse(DefaultBaseURL)
+		return u
+	}
+	u := *req.baseURL
+	return &u
+}
+
+// Events method gets natural events.
+func (req *Request) Events(ctx context.Context) (*Events, error) {
+	if req == nil {
+		return nil, errs.Wrap(ecode.ErrNullPointer)
+	}
+	q, err := req.makeQuery()
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	var events Events
+	if err := req.Client().RequestJSON(ctx, EventsPath, q, &events); err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return &events, nil
+}
+
+// EventsGeoJSON method gets natural events as GeoJSON FeatureCollection.
+func (req *Request) EventsGeoJSON(ctx context.Context) (*FeatureCollection, error) {
+	if req == nil {
+		return nil, errs.Wrap(ecode.ErrNullPointer)
+	}
+	q, err := req.makeQuery()
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	var fc FeatureCollection
+	if err := req.Client().RequestJSON(ctx, path.Join(EventsPath, "geojson"), q, &fc); err != nil {
+		return nil, errs.Wrap(err)
+	}
+	if fc.Features == nil {
+		fc.Features = []*Feature{}
+	}
+	return &fc, nil
+}
+
+// Categories method gets list of event categories.
+func (req *Request) Categories(ctx context.Context) (*Categories, error) {
+	if req == nil {
+		return nil, errs.Wrap(ecode.ErrNullPointer)
+	}
+	var categories Categories
+	if err := req.Client().RequestJSON(ctx, CategoriesPath, url.Values{}, &categories); err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return &categories, nil
+}
+
+// Sources method gets list of event sources.
+func (req *Request) Sources(ctx context.Context) (*Sources, error) {
+	if req == nil {
+		return nil, errs.Wrap(ecode.ErrNullPointer)
+	}
+	var sources Sources
+	if err := req.Client().RequestJSON(ctx, SourcesPath, url.Values{}, &sources); err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return &sources, nil
+}
+
+// Layers method gets list of web service layers. If category is not empty, gets layers of the category.
+func (req *Request) Layers(ctx context.Context, category string) (*Layers, error) {
+	if req == nil {
+		return nil, errs.Wrap(ecode.ErrNullPointer)
+	}
+	p := LayersPath
+	if len(category) > 0 {
+		if strings.ContainsAny(category, "/?#.") {
+			return nil, errs.Wrap(ecode.ErrInvalidParameter, errs.WithContext("category", category))
+		}
+		p = path.Join(p, category)
+	}
+	var layers Layers
+	if err := req.Client().RequestJSON(ctx, p, url.Values{}, &layers); err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return &layers, nil
+}
+
+func (req *Request) makeQuery() (url.Values, error) {
+	q := url.Values{}
+	if len(req.Status) > 0 {
+		q.Set("status", string(req.Status))
+	}
+	if len(req.Category) > 0 {
+		q.Set("category", strings.Join(req.Category, ","))
+	}
+	if len(req.Source) > 0 {
+		q.Set("source", strings.Join(req.Source, ","))
+	}
+	if req.BBox != nil {
+		q.Set("bbox", req.BBox.String())
+	}
+	if req.Days > 0 {
+		if !req.Start.IsZero() || !req.End.IsZero() {
+			return nil, errs.Wrap(ecode.ErrCombination, errs.WithContext("config", req))
+		}
+		q.Set("days", strconv.Itoa(req.Days))
+	}
+	if req.Limit > 0 {
+		q.Set("limit", strconv.Itoa(req.Limit))
+	}
+	if !req.Start.IsZero() {
+		q.Set("start", req.Start.Format(time.DateOnly))
+	}
+	if !req.End.IsZero() {
+		if !req.Start.IsZero() && req.End.Before(req.Start.Time) {
+			return nil, errs.Wrap(ecode.ErrEndBeforeStart, errs.WithContext("config", req))
+		}
+		q.Set("end", req.End.Format(time.DateOnly))
+	}
+	return q, nil
+}
+
+/* MIT License
+ *
+ * Copyright 2023 Spiegel
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
@@ -0,0 +1,144 @@
+package eonet
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/goark/apod/ecode"
+	"github.com/goark/apod/internal/testutil"
+	"github.com/goark/apod/nasaapi"
+)
+
+func TestParseBBox(t *testing.T) {
+	testCases := []struct {
+		s    string
+		want string
+		err  error
+	}{
+		{s: "", want: "", err: nil},
+		{s: "-129.02, 50.73, -58.71, 12.89", want: "-129.02,50.73,-58.71,12.89", err: nil},
+		{s: "-129.02,50.73,-58.71", err: ecode.ErrInvalidParameter},
+		{s: "-129.02,50.73,foo,12.89", err: ecode.ErrInvalidParameter},
+		{s: "-58.71,50.73,-129.02,12.89", err: ecode.ErrInvalidParameter},
+	}
+
+	for _, tc := range testCases {
+		bbox, err := ParseBBox(tc.s)
+		if !errors.Is(err, tc.err) {
+			t.Errorf("ParseBBox(%v) is \"%v\", want \"%v\"", tc.s, err, tc.err)
+		} else if err == nil && bbox.String() != tc.want {
+			t.Errorf("ParseBBox(%v) is \"%v\", want \"%v\"", tc.s, bbox, tc.want)
+		}
+	}
+}
+
+func TestMakeQuery(t *testing.T) {
+	start, _ := nasaapi.DateFrom("2023-01-01")
+	end, _ := nasaapi.DateFrom("2023-01-31")
+	bbox, _ := ParseBBox("-129.02,50.73,-58.71,12.89")
+	testCases := []struct {
+		req *Request
+		q   string
+		err error
+	}{
+		{req: New(), q: "", err: nil},
+		{req: New(WithStatus(StatusAll), WithCategory("wildfires", "volcanoes"), WithSource("InciWeb"), WithBBox(bbox), WithDays(20), WithLimit(5)), q: "bbox=-129.02%2C50.73%2C-58.71%2C12.89&category=wildfires%2Cvolcanoes&days=20&limit=5&source=InciWeb&status=all", err: nil},
+		{req: New(WithDateRange(start, end)), q: "end=2023-01-31&start=2023-01-01", err: nil},
+		{req: New(WithDateRange(end, start)), err: ecode.ErrEndBeforeStart},
+		{req: New(WithDateRange(start, end), WithDays(20)), err: ecode.ErrCombination},
+	}
+
+	for _, tc := range testCases {
+		q, err := tc.req.makeQuery()
+		if !errors.Is(err, tc.err) {
+			t.Errorf("makeQuery() is \"%v\", want \"%v\"", err, tc.err)
+		} else if err == nil && q.Encode() != tc.q {
+			t.Errorf("makeQuery() is \"%v\", want \"%v\"", q.Encode(), tc.q)
+		}
+	}
+}
+
+func TestEvents(t *testing.T) {
+	var gotQuery string
+	u, _ := testutil.NewServer(t, func(w http.ResponseWriter, r *http.Request) {
+		gotQuery = r.URL.RawQuery
+		w.Header().Set(nasaapi.HeaderRateLimitLimit, "1000")
+		w.Header().Set(nasaapi.HeaderRateLimitRemaining, "999")
+		switch r.URL.Path {
+		case EventsPath:
+			_, _ = w.Write([]byte(`{"title":"EONET Events","events":[{"id":"EONET_6300","title":"Wildfire","description":null,"link":"https://eonet.gsfc.nasa.gov/api/v3/events/EONET_6300","closed":null,"categories":[{"id":"wildfires","title":"Wildfires"}],"sources":[{"id":"InciWeb","url":"https://example.com"}],"geometry":[{"magnitudeValue":null,"magnitudeUnit":null,"date":"2023-01-10T00:00:00Z","type":"Point","coordinates":[-122.1,38.2]}]}]}`))
+		case EventsPath + "/geojson":
+			_, _ = w.Write([]byte(`{"type":"FeatureCollection","features":[{"type":"Feature","properties":{"id":"EONET_6300","title":"Wildfire","date":"2023-01-10T00:00:00Z","closed":null,"categories":[{"id":"wildfires","title":"Wildfires"}]},"geometry":{"type":"Polygon","coordinates":[[[-122.1,38.2],[-122.0,38.2],[-122.0,38.3],[-122.1,38.2]]]}}]}`))
+		case LayersPath + "/wildfires":
+			_, _ = w.Write([]byte(`{"title":"EONET Web Service Layers","categories":[]}`))
+		default:
+			http.Error(w, `{"message":"not found"}`, http.StatusNotFound)
+		}
+	})
+	// base URL of nasaapi.Client is not used
+	req := New(WithStatus(StatusClosed), WithCategory("wildfires"), WithLimit(5), WithBaseURL(u), WithClient(nasaapi.NewClient(nasaapi.WithKeyLimiter(nil))))
+
+	events, err := req.Events(context.Background())
+	if err != nil {
+		t.Fatalf("Events() is \"%v\", want nil", err)
+	}
+	if want := "category=wildfires&limit=5&status=closed"; gotQuery != want {
+		t.Errorf("query of Events() is \"%v\", want \"%v\"", gotQuery, want)
+	}
+	if len(events.Events) != 1 || events.Events[0].Closed != nil || string(events.Events[0].Geometry[0].Coordinates) != "[-122.1,38.2]" {
+		t.Errorf("Events() is %+v", events.Events)
+	}
+	if rl, ok := req.Client().RateLimit(); !ok || rl.Remaining != 999 {
+		t.Errorf("Client().RateLimit() is (%v, %v), want remaining 999", rl, ok)
+	}
+
+	fc, err := req.EventsGeoJSON(context.Background())
+	if err != nil {
+		t.Fatalf("EventsGeoJSON() is \"%v\", want nil", err)
+	}
+	if want := "category=wildfires&limit=5&status=closed"; gotQuery != want {
+		t.Errorf("query of EventsGeoJSON() is \"%v\", want \"%v\"", gotQuery, want)
+	}
+	if fc.Type != "FeatureCollection" || len(fc.Features) != 1 || fc.Features[0].Geometry.Type != "Polygon" || fc.Features[0].Properties.ID != "EONET_6300" {
+		t.Errorf("EventsGeoJSON() is %+v", fc)
+	}
+
+	testCases := []struct {
+		category string
+		err      error
+	}{
+		{category: "wildfires", err: nil},
+		{category: "volcanoes", err: ecode.ErrNotFound},
+		{category: "../events", err: ecode.ErrInvalidParameter},
+	}
+	for _, tc := range testCases {
+		if _, err := req.Layers(context.Background(), tc.category); !errors.Is(err, tc.err) {
+			t.Errorf("Layers(%v) is \"%v\", want \"%v\"", tc.category, err, tc.err)
+		}
+	}
+}
+
+/* MIT License
+ *
+ * Copyright 2023 Spiegel
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
@@ -0,0 +1,159 @@
+package eonet
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// Events is response data from /events.
+type Events struct {
+	Title       string   `json:"title"`
+	Description string   `json:"description"`
+	Link        string   `json:"link"`
+	Events      []*Event `json:"events"`
+}
+
+// Event is natural event.
+type Event struct {
+	ID          string         `json:"id"`
+	Title       string         `json:"title"`
+	Description string         `json:"description,omitempty"`
+	Link        string         `json:"link"`
+	Closed      *time.Time     `json:"closed"` // nil if the event is open
+	Categories  []*CategoryRef `json:"categories"`
+	Sources     []*SourceRef   `json:"sources"`
+	Geometry    []*Geometry    `json:"geometry"`
+}
+
+// CategoryRef is reference to category of event.
+type CategoryRef struct {
+	ID    string `json:"id"`
+	Title string `json:"title"`
+}
+
+// SourceRef is reference to source of event.
+type SourceRef struct {
+	ID  string `json:"id"`
+	URL string `json:"url"`
+}
+
+// Geometry is location of event at the date.
+type Geometry struct {
+	MagnitudeValue *float64        `json:"magnitudeValue"`
+	MagnitudeUnit  *string         `json:"magnitudeUnit"`
+	Date           time.Time       `json:"date"`
+	Type           string          `json:"type"`        // GeoJSON geometry type (Point or Polygon)
+	Coordinates    json.RawMessage `json:"coordinates"` // GeoJSON coordinates
+}
+
+// Categories is response data from /categories.
+type Categories struct {
+	Title       string      `json:"title"`
+	Description string      `json:"description"`
+	Link        string      `json:"link"`
+	Categories  []*Category `json:"categories"`
+}
+
+// Category is category of natural events.
+type Category struct {
+	ID          string `json:"id"`
+	Title       string `json:"title"`
+	Link        string `json:"link"`
+	Description string `json:"description"`
+	Layers      string `json:"layers"`
+}
+
+// Sources is response data from /sources.
+type Sources struct {
+	Title       string    `json:"title"`
+	Description string    `json:"description"`
+	Link        string    `json:"link"`
+	Sources     []*Source `json:"sources"`
+}
+
+// Source is source of natural events.
+type Source struct {
+	ID     string `json:"id"`
+	Title  string `json:"title"`
+	Source string `json:"source"`
+	Link   string `json:"link"`
+}
+
+// Layers is response data from /layers.
+type Layers struct {
+	Title       string            `json:"title"`
+	Description string            `json:"description"`
+	Link        string            `json:"link"`
+	Categories  []*CategoryLayers `json:"categories"`
+}
+
+// CategoryLayers is list of web service layers of category.
+type CategoryLayers struct {
+	ID     string   `json:"id"`
+	Title  string   `json:"title"`
+	Layers []*Layer `json:"layers"`
+}
+
+// Layer is web service layer (WMS, WMTS, ...) for the imagery related to events.
+type Layer struct {
+	Name          string            `json:"name"`
+	ServiceURL    string            `json:"serviceUrl"`
+	ServiceTypeID string            `json:"serviceTypeId"`
+	Parameters    []json.RawMessage `json:"parameters"`
+}
+
+// FeatureCollection is GeoJSON FeatureCollection of natural events.
+type FeatureCollection struct {
+	Type     string     `json:"type"` // "FeatureCollection"
+	Features []*Feature `json:"features"`
+}
+
+// Feature is GeoJSON Feature of natural event at the date.
+type Feature struct {
+	Type       string           `json:"type"` // "Feature"
+	Properties *Properties      `json:"properties"`
+	Geometry   *GeoJSONGeometry `json:"geometry"`
+}
+
+// Properties is properties of GeoJSON Feature.
+type Properties struct {
+	ID             string         `json:"id"`
+	Title          string         `json:"title"`
+	Description    string         `json:"description,omitempty"`
+	Link           string         `json:"link"`
+	Closed         *time.Time     `json:"closed"`
+	Date           time.Time      `json:"date"`
+	MagnitudeValue *float64       `json:"magnitudeValue"`
+	MagnitudeUnit  *string        `json:"magnitudeUnit"`
+	Categories     []*CategoryRef `json:"categories"`
+	Sources        []*SourceRef   `json:"sources"`
+}
+
+// GeoJSONGeometry is GeoJSON geometry object.
+type GeoJSONGeometry struct {
+	Type        string          `json:"type"`
+	Coordinates json.RawMessage `json:"coordinates"`
+}
+
+/* MIT License
+ *
+ * Copyright 2023 Spiegel
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
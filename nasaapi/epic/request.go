@@ -0,0 +1,229 @@
+package epic
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/goark/apod/ecode"
+	"github.com/goark/apod/nasaapi"
+	"github.com/goark/errs"
+)
+
+const (
+	APIPath     = "/EPIC/api"     // base path of EPIC metadata API
+	ArchivePath = "/EPIC/archive" // base path of EPIC image archive
+)
+
+// Collection is collection of EPIC images.
+type Collection string
+
+const (
+	Natural  Collection = "natural"
+	Enhanced Collection = "enhanced"
+)
+
+// CollectionFrom returns Collection from string (case insensitive). Empty string is Natural.
+func CollectionFrom(s string) (Collection, error) {
+	switch strings.ToLower(s) {
+	case "", string(Natural):
+		return Natural, nil
+	case string(Enhanced):
+		return Enhanced, nil
+	default:
+		return "", errs.Wrap(ecode.ErrInvalidParameter, errs.WithContext("collection", s))
+	}
+}
+
+// Format is format of EPIC archive images.
+type Format string
+
+const (
+	PNG    Format = "png"    // full resolution PNG (2048x2048)
+	JPG    Format = "jpg"    // half resolution JPEG (1024x1024)
+	Thumbs Format = "thumbs" // thumbnail JPEG
+)
+
+// FormatFrom returns Format from string (case insensitive). Empty string is PNG.
+func FormatFrom(s string) (Format, error) {
+	switch strings.ToLower(s) {
+	case "", string(PNG):
+		return PNG, nil
+	case string(JPG), "jpeg":
+		return JPG, nil
+	case string(Thumbs), "thumb":
+		return Thumbs, nil
+	default:
+		return "", errs.Wrap(ecode.ErrInvalidParameter, errs.WithContext("format", s))
+	}
+}
+
+// Ext method returns file extension of Format.
+func (f Format) Ext() string {
+	if f == PNG {
+		return ".png"
+	}
+	return ".jpg"
+}
+
+// Request is for context of EPIC (Earth Polychromatic Imaging Camera) API.
+type Request struct {
+	Collection Collection   `json:"collection"`     // Collection of images (default is natural)
+	Date       nasaapi.Date `json:"date,omitempty"` // Date of images (default is most recent date)
+	APIKey     string       `json:"api_key"`        // api.nasa.gov key for expanded usage
+	client     *nasaapi.Client
+}
+
+type Opts func(*Request)
+
+// New returns new Request instance for EPIC API.
+func New(opts ...Opts) *Request {
+	ctx := &Request{Collection: Natural}
+	for _, opt := range opts {
+		opt(ctx)
+	}
+	return ctx
+}
+
+// WithCollection returns function for setting Request.Collection.
+func WithCollection(collection Collection) Opts {
+	return func(ctx *Request) {
+		if ctx != nil {
+			ctx.Collection = collection
+		}
+	}
+}
+
+// WithDate returns function for setting Request.Date.
+func WithDate(date nasaapi.Date) Opts {
+	return func(ctx *Request) {
+		if ctx != nil {
+			ctx.Date = date
+		}
+	}
+}
+
+// WithAPIKey returns function for setting Request.APIKey.
+func WithAPIKey(apiKey string) Opts {
+	return func(ctx *Request) {
+		if ctx != nil {
+			ctx.APIKey = apiKey
+		}
+	}
+}
+
+// WithClient returns function for setting nasaapi.Client.
+func WithClient(client *nasaapi.Client) Opts {
+	return func(ctx *Request) {
+		if ctx != nil {
+			ctx.client = client
+		}
+	}
+}
+
+// Client method returns nasaapi.Client instance for requesting. If not set, returns nasaapi.DefaultClient().
+func (req *Request) Client() *nasaapi.Client {
+	if req == nil || req.client == nil {
+		return nasaapi.DefaultClient()
+	}
+	return req.client
+}
+
+// Images method gets metadata of images at Request.Date. If Request.Date is zero, gets most recent images.
+func (req *Request) Images(ctx context.Context) ([]*Image, error) {
+	if req == nil {
+		return nil, errs.Wrap(ecode.ErrNullPointer)
+	}
+	p := req.apiPath()
+	if !req.Date.IsZero() {
+		p = path.Join(p, "date", req.Date.Format(time.DateOnly))
+	}
+	images := []*Image{}
+	if err := req.Client().RequestJSON(ctx, p, req.query(), &images); err != nil {
+		return nil, errs.Wrap(err, errs.WithContext("date", req.Date))
+	}
+	return images, nil
+}
+
+// Latest method gets metadata of most recent images.
+func (req *Request) Latest(ctx context.Context) ([]*Image, error) {
+	if req == nil {
+		return nil, errs.Wrap(ecode.ErrNullPointer)
+	}
+	r := *req
+	r.Date = nasaapi.Date{}
+	return r.Images(ctx)
+}
+
+// AvailableDates method gets list of dates with available images.
+func (req *Request) AvailableDates(ctx context.Context) ([]nasaapi.Date, error) {
+	if req == nil {
+		return nil, errs.Wrap(ecode.ErrNullPointer)
+	}
+	dates := []nasaapi.Date{}
+	if err := req.Client().RequestJSON(ctx, path.Join(req.apiPath(), "available"), req.query(), &dates); err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return dates, nil
+}
+
+// ImageURL method returns URL of archive image.
+func (req *Request) ImageURL(img *Image, format Format) (*url.URL, error) {
+	if req == nil || img == nil {
+		return nil, errs.Wrap(ecode.ErrNullPointer)
+	}
+	if img.Date.IsZero() || len(img.Image) == 0 {
+		return nil, errs.Wrap(ecode.ErrInvalidParameter, errs.WithContext("image", img.Image), errs.WithContext("date", img.Date))
+	}
+	p := path.Join(
+		ArchivePath,
+		string(req.collection()),
+		fmt.Sprintf("%04d/%02d/%02d", img.Date.Year(), img.Date.Month(), img.Date.Day()),
+		string(format),
+		img.Image+format.Ext(),
+	)
+	return req.Client().URL(p, req.query()), nil
+}
+
+func (req *Request) collection() Collection {
+	if len(req.Collection) == 0 {
+		return Natural
+	}
+	return req.Collection
+}
+
+func (req *Request) apiPath() string {
+	return path.Join(APIPath, string(req.collection()))
+}
+
+func (req *Request) query() url.Values {
+	q := url.Values{}
+	q.Set("api_key", nasaapi.APIKey(req.APIKey))
+	return q
+}
+
+/* MIT License
+ *
+ * Copyright 2023 Spiegel
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
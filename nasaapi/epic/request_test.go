@@ -0,0 +1,137 @@
+package epic
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/goark/apod/ecode"
+	"github.com/goark/apod/internal/testutil"
+)
+
+func TestImages(t *testing.T) {
+	var gotPath, gotKey string
+	u, cli := testutil.NewServer(t, func(w http.ResponseWriter, r *http.Request) {
+		gotPath, gotKey = r.URL.Path, r.URL.Query().Get("api_key")
+		switch r.URL.Path {
+		case "/EPIC/api/natural", "/EPIC/api/enhanced/date/2019-05-30":
+			_, _ = w.Write([]byte(`[{"identifier":"20190530011359","caption":"foo","image":"epic_1b_20190530011359","version":"02","centroid_coordinates":{"lat":2.8,"lon":-173.5},"sun_j2000_position":{"x":1,"y":2,"z":3},"date":"2019-05-30 00:13:59"}]`))
+		case "/EPIC/api/natural/available":
+			_, _ = w.Write([]byte(`["2015-06-13","2015-06-16"]`))
+		default:
+			http.Error(w, `{"code":404,"msg":"not found"}`, http.StatusNotFound)
+		}
+	})
+
+	testCases := []struct {
+		req  *Request
+		path string
+		key  string
+		err  error
+		url  string
+	}{
+		{req: New(WithClient(cli)), path: "/EPIC/api/natural", key: "DEMO_KEY", err: nil, url: u.String() + "/EPIC/archive/natural/2019/05/30/png/epic_1b_20190530011359.png?api_key=DEMO_KEY"},
+		{req: New(WithClient(cli), WithCollection(Enhanced), WithDate(testutil.DateFromMust(t, "2019-05-30")), WithAPIKey("foo")), path: "/EPIC/api/enhanced/date/2019-05-30", key: "foo", err: nil, url: u.String() + "/EPIC/archive/enhanced/2019/05/30/png/epic_1b_20190530011359.png?api_key=foo"},
+		{req: New(WithClient(cli), WithDate(testutil.DateFromMust(t, "2015-01-01"))), path: "/EPIC/api/natural/date/2015-01-01", key: "DEMO_KEY", err: ecode.ErrNotFound},
+	}
+
+	for _, tc := range testCases {
+		images, err := tc.req.Images(context.Background())
+		if gotPath != tc.path || gotKey != tc.key {
+			t.Errorf("request is \"%v?api_key=%v\", want \"%v?api_key=%v\"", gotPath, gotKey, tc.path, tc.key)
+		}
+		if !errors.Is(err, tc.err) {
+			t.Errorf("Images() is \"%v\", want \"%v\"", err, tc.err)
+		}
+		if err != nil {
+			continue
+		}
+		if len(images) != 1 {
+			t.Errorf("Images() is %v, want 1 image", images)
+			continue
+		}
+		iu, err := tc.req.ImageURL(images[0], PNG)
+		if err != nil {
+			t.Errorf("ImageURL() is \"%v\", want nil", err)
+		} else if iu.String() != tc.url {
+			t.Errorf("ImageURL() is \"%v\", want \"%v\"", iu, tc.url)
+		}
+	}
+
+	// Latest method ignores Request.Date
+	if _, err := New(WithClient(cli), WithDate(testutil.DateFromMust(t, "2015-01-01"))).Latest(context.Background()); err != nil {
+		t.Errorf("Latest() is \"%v\", want nil", err)
+	} else if gotPath != "/EPIC/api/natural" {
+		t.Errorf("path of Latest() is \"%v\", want \"%v\"", gotPath, "/EPIC/api/natural")
+	}
+
+	dates, err := New(WithClient(cli)).AvailableDates(context.Background())
+	if err != nil {
+		t.Errorf("AvailableDates() is \"%v\", want nil", err)
+	} else if len(dates) != 2 || dates[1].String() != "2015-06-16" {
+		t.Errorf("AvailableDates() is %v, want [2015-06-13 2015-06-16]", dates)
+	}
+}
+
+func TestImageURLInvalid(t *testing.T) {
+	testCases := []struct {
+		img *Image
+		err error
+	}{
+		{img: nil, err: ecode.ErrNullPointer},
+		{img: &Image{Image: "epic_1b_20190530011359"}, err: ecode.ErrInvalidParameter},
+		{img: &Image{Date: DateTime{Time: time.Date(2019, 5, 30, 0, 13, 59, 0, time.UTC)}}, err: ecode.ErrInvalidParameter},
+	}
+
+	for _, tc := range testCases {
+		if _, err := New().ImageURL(tc.img, PNG); !errors.Is(err, tc.err) {
+			t.Errorf("ImageURL(%+v) is \"%v\", want \"%v\"", tc.img, err, tc.err)
+		}
+	}
+}
+
+func TestFormatFrom(t *testing.T) {
+	testCases := []struct {
+		s   string
+		f   Format
+		ext string
+	}{
+		{s: "", f: PNG, ext: ".png"},
+		{s: "JPEG", f: JPG, ext: ".jpg"},
+		{s: "thumbs", f: Thumbs, ext: ".jpg"},
+	}
+
+	for _, tc := range testCases {
+		f, err := FormatFrom(tc.s)
+		if err != nil {
+			t.Errorf("FormatFrom(%v) is \"%v\", want nil", tc.s, err)
+		} else if f != tc.f || f.Ext() != tc.ext {
+			t.Errorf("FormatFrom(%v) is \"%v\" (%v), want \"%v\" (%v)", tc.s, f, f.Ext(), tc.f, tc.ext)
+		}
+	}
+}
+
+/* MIT License
+ *
+ * Copyright 2023 Spiegel
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
@@ -0,0 +1,109 @@
+package epic
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/goark/errs"
+)
+
+// Image is metadata of EPIC image.
+type Image struct {
+	Identifier          string      `json:"identifier"`
+	Caption             string      `json:"caption"`
+	Image               string      `json:"image"` // image name (without extension)
+	Version             string      `json:"version"`
+	CentroidCoordinates LatLon      `json:"centroid_coordinates"`
+	DSCOVRJ2000Position Position    `json:"dscovr_j2000_position"`
+	LunarJ2000Position  Position    `json:"lunar_j2000_position"`
+	SunJ2000Position    Position    `json:"sun_j2000_position"`
+	AttitudeQuaternions Quaternions `json:"attitude_quaternions"`
+	Date                DateTime    `json:"date"`
+}
+
+// LatLon is geographical coordinates.
+type LatLon struct {
+	Lat float64 `json:"lat"`
+	Lon float64 `json:"lon"`
+}
+
+// Position is position in J2000 coordinate system (km).
+type Position struct {
+	X float64 `json:"x"`
+	Y float64 `json:"y"`
+	Z float64 `json:"z"`
+}
+
+// Quaternions is attitude quaternions of DSCOVR spacecraft.
+type Quaternions struct {
+	Q0 float64 `json:"q0"`
+	Q1 float64 `json:"q1"`
+	Q2 float64 `json:"q2"`
+	Q3 float64 `json:"q3"`
+}
+
+// DateTime is wrapper class of time.Time for EPIC API ("2006-01-02 15:04:05" in UTC).
+type DateTime struct {
+	time.Time
+}
+
+const dateTimeLayout = "2006-01-02 15:04:05"
+
+// DateTimeFrom returns DateTime instance from string.
+func DateTimeFrom(s string) (DateTime, error) {
+	if len(s) == 0 || strings.EqualFold(s, "null") {
+		return DateTime{}, nil
+	}
+	tm, err := time.Parse(dateTimeLayout, s)
+	if err != nil {
+		return DateTime{}, errs.Wrap(err, errs.WithContext("time_string", s), errs.WithContext("time_template", dateTimeLayout))
+	}
+	return DateTime{tm}, nil
+}
+
+// Stringer with "2006-01-02 15:04:05" format.
+func (t DateTime) String() string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.Format(dateTimeLayout)
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (t DateTime) MarshalJSON() ([]byte, error) {
+	return []byte(strconv.Quote(t.String())), nil
+}
+
+// UnmarshalJSON implements the json.UnmarshalJSON interface.
+func (t *DateTime) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		s = string(b)
+	}
+	*t, err = DateTimeFrom(s)
+	return err
+}
+
+/* MIT License
+ *
+ * Copyright 2023 Spiegel
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
@@ -0,0 +1,129 @@
+package nasaapi
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/goark/apod/ecode"
+)
+
+const maxErrorBodySize = 64 * 1024 //64KB
+
+// APIError is error information returned from NASA API.
+type APIError struct {
+	StatusCode int    `json:"status_code"`       // HTTP status code
+	Code       string `json:"code,omitempty"`    // NASA error code (e.g. "OVER_RATE_LIMIT")
+	Message    string `json:"message,omitempty"` // error message from NASA API
+	Path       string `json:"path,omitempty"`    // request path
+
+	RetryAfter time.Duration `json:"-"` // value of Retry-After header
+}
+
+// errorBody is JSON format of error response from NASA API.
+//
+//	{"code":400,"msg":"Date must be between Jun 16, 1995 and ...","service_version":"v1"}
+//	{"error":{"code":"OVER_RATE_LIMIT","message":"You have exceeded your rate limit. ..."}}
+//	{"code":404,"http_error":"NOT_FOUND","error_message":"...","request":"..."}
+type errorBody struct {
+	Code         json.RawMessage `json:"code,omitempty"`
+	Msg          string          `json:"msg,omitempty"`
+	HTTPError    string          `json:"http_error,omitempty"`
+	ErrorMessage string          `json:"error_message,omitempty"`
+	Error        *struct {
+		Code    string `json:"code,omitempty"`
+		Message string `json:"message,omitempty"`
+	} `json:"error,omitempty"`
+}
+
+// newAPIError returns APIError instance from error response.
+func newAPIError(resp *http.Response) *APIError {
+	apiErr := &APIError{StatusCode: resp.StatusCode, RetryAfter: parseRetryAfter(resp.Header.Get("Retry-After"))}
+	if resp.Request != nil && resp.Request.URL != nil {
+		apiErr.Path = resp.Request.URL.Path
+	}
+	b, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorBodySize))
+	var body errorBody
+	if err := json.Unmarshal(b, &body); err == nil {
+		if body.Error != nil {
+			apiErr.Code = body.Error.Code
+			apiErr.Message = body.Error.Message
+		} else {
+			apiErr.Code = body.HTTPError
+			if len(apiErr.Code) == 0 && len(body.Code) > 0 {
+				if s, err := strconv.Unquote(string(body.Code)); err == nil {
+					apiErr.Code = s
+				}
+			}
+			apiErr.Message = body.Msg
+			if len(apiErr.Message) == 0 {
+				apiErr.Message = body.ErrorMessage
+			}
+		}
+	}
+	if len(apiErr.Message) == 0 {
+		apiErr.Message = http.StatusText(resp.StatusCode)
+	}
+	return apiErr
+}
+
+// Error method returns error message. (implementation of error interface)
+func (e *APIError) Error() string {
+	if e == nil {
+		return "<nil>"
+	}
+	if len(e.Code) > 0 {
+		return fmt.Sprintf("%s (HTTP %d, %s)", e.Message, e.StatusCode, e.Code)
+	}
+	return fmt.Sprintf("%s (HTTP %d)", e.Message, e.StatusCode)
+}
+
+// Is method reports whether APIError matches target error in ecode package.
+func (e *APIError) Is(target error) bool {
+	if e == nil {
+		return false
+	}
+	switch {
+	case errors.Is(target, ecode.ErrHTTPStatus):
+		return true
+	case errors.Is(target, ecode.ErrRateLimited):
+		return e.StatusCode == http.StatusTooManyRequests || e.Code == "OVER_RATE_LIMIT"
+	case errors.Is(target, ecode.ErrInvalidAPIKey):
+		return e.Code == "API_KEY_INVALID" || e.Code == "API_KEY_MISSING" || e.Code == "API_KEY_DISABLED" || e.Code == "API_KEY_UNAUTHORIZED"
+	case errors.Is(target, ecode.ErrDateOutOfRange):
+		return e.StatusCode == http.StatusBadRequest && strings.Contains(strings.ToLower(e.Message), "date must be between")
+	case errors.Is(target, ecode.ErrNotFound):
+		return e.StatusCode == http.StatusNotFound
+	case errors.Is(target, ecode.ErrServerError):
+		return e.StatusCode >= http.StatusInternalServerError
+	}
+	return false
+}
+
+/* MIT License
+ *
+ * Copyright 2023 Spiegel
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
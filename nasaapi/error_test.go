@@ -0,0 +1,76 @@
+package nasaapi
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/goark/apod/ecode"
+)
+
+func TestAPIError(t *testing.T) {
+	testCases := []struct {
+		status  int
+		body    string
+		code    string
+		message string
+		target  error
+	}{
+		{status: http.StatusBadRequest, body: `{"code":400,"msg":"Date must be between Jun 16, 1995 and Feb 25, 2023.","service_version":"v1"}`, code: "", message: "Date must be between Jun 16, 1995 and Feb 25, 2023.", target: ecode.ErrDateOutOfRange},
+		{status: http.StatusTooManyRequests, body: `{"error":{"code":"OVER_RATE_LIMIT","message":"You have exceeded your rate limit."}}`, code: "OVER_RATE_LIMIT", message: "You have exceeded your rate limit.", target: ecode.ErrRateLimited},
+		{status: http.StatusForbidden, body: `{"error":{"code":"API_KEY_INVALID","message":"An invalid api_key was supplied."}}`, code: "API_KEY_INVALID", message: "An invalid api_key was supplied.", target: ecode.ErrInvalidAPIKey},
+		{status: http.StatusNotFound, body: `{"code":404,"http_error":"NOT_FOUND","error_message":"Asteroid not found","request":"..."}`, code: "NOT_FOUND", message: "Asteroid not found", target: ecode.ErrNotFound},
+		{status: http.StatusBadGateway, body: `<html>Bad Gateway</html>`, code: "", message: "Bad Gateway", target: ecode.ErrServerError},
+	}
+
+	for _, tc := range testCases {
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(tc.status)
+			_, _ = w.Write([]byte(tc.body))
+		}))
+		u, _ := url.Parse(ts.URL)
+		_, err := NewClient(WithBaseURL(u)).Request(context.Background(), "/foo", url.Values{"api_key": []string{"secret"}})
+		ts.Close()
+
+		var apiErr *APIError
+		if !errors.As(err, &apiErr) {
+			t.Errorf("Request() is \"%v\", want APIError", err)
+			continue
+		}
+		if apiErr.StatusCode != tc.status || apiErr.Code != tc.code || apiErr.Message != tc.message || apiErr.Path != "/foo" {
+			t.Errorf("APIError is %+v, want {%v %v %v /foo}", apiErr, tc.status, tc.code, tc.message)
+		}
+		if !errors.Is(err, tc.target) {
+			t.Errorf("errors.Is(%v, %v) is false, want true", err, tc.target)
+		}
+		if !errors.Is(err, ecode.ErrHTTPStatus) {
+			t.Errorf("errors.Is(%v, %v) is false, want true", err, ecode.ErrHTTPStatus)
+		}
+	}
+}
+
+/* MIT License
+ *
+ * Copyright 2023 Spiegel
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
@@ -0,0 +1,192 @@
+package exoplanet
+
+import (
+	"context"
+	"io"
+	"net/url"
+	"strings"
+
+	"github.com/goark/apod/ecode"
+	"github.com/goark/apod/nasaapi"
+	"github.com/goark/errs"
+)
+
+const (
+	DefaultBaseURL = "https://exoplanetarchive.ipac.caltech.edu" // Default base URL of NASA Exoplanet Archive
+
+	SyncPath = "/TAP/sync" // path of synchronous TAP query
+)
+
+// Format is format of TAP query result.
+type Format string
+
+const (
+	CSV  Format = "csv"
+	JSON Format = "json"
+)
+
+// FormatFrom returns Format from string (case insensitive). Empty string is CSV.
+func FormatFrom(s string) (Format, error) {
+	switch strings.ToLower(s) {
+	case "", string(CSV):
+		return CSV, nil
+	case string(JSON):
+		return JSON, nil
+	default:
+		return "", errs.Wrap(ecode.ErrInvalidParameter, errs.WithContext("format", s))
+	}
+}
+
+// Request is for context of NASA Exoplanet Archive TAP (Table Access Protocol) service.
+type Request struct {
+	Query   string `json:"query"`  // ADQL query (e.g. "select pl_name,disc_year from ps where default_flag=1")
+	Format  Format `json:"format"` // format of query result (default is csv)
+	baseURL *url.URL
+	client  *nasaapi.Client
+	cli     *nasaapi.Client // client for base URL (made by New function)
+}
+
+type Opts func(*Request)
+
+// New returns new Request instance for NASA Exoplanet Archive TAP service.
+func New(opts ...Opts) *Request {
+	ctx := &Request{Format: CSV}
+	for _, opt := range opts {
+		opt(ctx)
+	}
+	ctx.cli = ctx.newClient()
+	return ctx
+}
+
+// WithQuery returns function for setting Request.Query.
+func WithQuery(adql string) Opts {
+	return func(ctx *Request) {
+		if ctx != nil {
+			ctx.Query = adql
+		}
+	}
+}
+
+// WithFormat returns function for setting Request.Format.
+func WithFormat(format Format) Opts {
+	return func(ctx *Request) {
+		if ctx != nil {
+			ctx.Format = format
+		}
+	}
+}
+
+// WithBaseURL returns function for setting base URL of NASA Exoplanet Archive.
+func WithBaseURL(u *url.URL) Opts {
+	return func(ctx *Request) {
+		if ctx != nil {
+			ctx.baseURL = u
+		}
+	}
+}
+
+// WithClient returns function for setting nasaapi.Client.
+// Base URL of the client is replaced by DefaultBaseURL (or WithBaseURL option).
+func WithClient(client *nasaapi.Client) Opts {
+	return func(ctx *Request) {
+		if ctx != nil {
+			ctx.client = client
+		}
+	}
+}
+
+// Client method returns nasaapi.Client instance for requesting NASA Exoplanet Archive.
+// It returns the same instance for each call, so rate limit information of the last request is available.
+func (req *Request) Client() *nasaapi.Client {
+	if req != nil && req.cli != nil {
+		return req.cli
+	}
+	return req.newClient()
+}
+
+func (req *Request) newClient() *nasaapi.Client {
+	var cli *nasaapi.Client
+	if req != nil && req.client != nil {
+		cli = req.client
+	} else {
+		cli = nasaapi.DefaultClient()
+	}
+	return cli.Clone(nasaapi.WithBaseURL(req.BaseURL()))
+}
+
+// BaseURL method returns base URL of NASA Exoplanet Archive.
+func (req *Request) BaseURL() *url.URL {
+	if req == nil || req.baseURL == nil {
+		u, _ := url.Parse(DefaultBaseURL)
+		return u
+	}
+	u := *req.baseURL
+	return &u
+}
+
+// Do method runs ADQL query, and returns result with dynamic rows.
+func (req *Request) Do(ctx context.Context) (*Result, error) {
+	r, err := req.GetRawData(ctx)
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	defer r.Close()
+	var res *Result
+	if req.format() == JSON {
+		res, err = DecodeJSON(r)
+	} else {
+		res, err = DecodeCSV(r)
+	}
+	if err != nil {
+		return nil, errs.Wrap(err, errs.WithContext("query", req.Query))
+	}
+	return res, nil
+}
+
+// GetRawData method runs ADQL query, and returns raw result data.
+func (req *Request) GetRawData(ctx context.Context) (io.ReadCloser, error) {
+	if req == nil {
+		return nil, errs.Wrap(ecode.ErrNullPointer)
+	}
+	if len(strings.TrimSpace(req.Query)) == 0 {
+		return nil, errs.Wrap(ecode.ErrInvalidParameter, errs.WithContext("query", req.Query))
+	}
+	q := url.Values{}
+	q.Set("query", req.Query)
+	q.Set("format", string(req.format()))
+	r, err := req.Client().Request(ctx, SyncPath, q)
+	if err != nil {
+		return nil, errs.Wrap(err, errs.WithContext("query", req.Query))
+	}
+	return r, nil
+}
+
+func (req *Request) format() Format {
+	if len(req.Format) == 0 {
+		return CSV
+	}
+	return req.Format
+}
+
+/* MIT License
+ *
+ * Copyright 2023 Spiegel
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
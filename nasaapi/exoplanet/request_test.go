@@ -0,0 +1,128 @@
+package exoplanet
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/goark/apod/ecode"
+	"github.com/goark/apod/internal/testutil"
+	"github.com/goark/apod/nasaapi"
+)
+
+func TestDo(t *testing.T) {
+	var query string
+	u, _ := testutil.NewServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != SyncPath {
+			http.NotFound(w, r)
+			return
+		}
+		query = r.URL.RawQuery
+		w.Header().Set(nasaapi.HeaderRateLimitLimit, "1000")
+		w.Header().Set(nasaapi.HeaderRateLimitRemaining, "999")
+		if r.URL.Query().Get("query") != "select pl_name,disc_year,pl_rade from ps" {
+			http.Error(w, "ERROR: table does not exist", http.StatusBadRequest)
+			return
+		}
+		switch r.URL.Query().Get("format") {
+		case "csv":
+			_, _ = w.Write([]byte("pl_name,disc_year,pl_rade\n\"Kepler-22 b\",2011,2.1\nTOI-700 d,2020,\n"))
+		case "json":
+			_, _ = w.Write([]byte(`[{"pl_name":"Kepler-22 b","disc_year":2011,"pl_rade":2.1},{"pl_name":"TOI-700 d","disc_year":2020,"pl_rade":null}]`))
+		}
+	})
+	// base URL of nasaapi.Client is not used
+	cli := nasaapi.NewClient(nasaapi.WithKeyLimiter(nil))
+
+	for _, format := range []Format{CSV, JSON} {
+		req := New(WithQuery("select pl_name,disc_year,pl_rade from ps"), WithFormat(format), WithBaseURL(u), WithClient(cli))
+		res, err := req.Do(context.Background())
+		if err != nil {
+			t.Errorf("Do() [%v] is \"%v\", want nil", format, err)
+			continue
+		}
+		if want := "format=" + string(format) + "&query=select+pl_name%2Cdisc_year%2Cpl_rade+from+ps"; query != want {
+			t.Errorf("query [%v] is \"%v\", want \"%v\"", format, query, want)
+		}
+		if rl, ok := req.Client().RateLimit(); !ok || rl.Remaining != 999 {
+			t.Errorf("Client().RateLimit() [%v] is (%v, %v), want remaining 999", format, rl, ok)
+		}
+		if got := strings.Join(res.Columns, ","); got != "pl_name,disc_year,pl_rade" {
+			t.Errorf("Columns [%v] is \"%v\", want \"%v\"", format, got, "pl_name,disc_year,pl_rade")
+		}
+		if len(res.Rows) != 2 {
+			t.Errorf("Rows [%v] is %v, want 2 rows", format, res.Rows)
+			continue
+		}
+		if f, ok := res.Rows[0].Float("pl_rade"); !ok || f != 2.1 {
+			t.Errorf("Float() [%v] is %v (%v), want 2.1", format, f, ok)
+		}
+		if _, ok := res.Rows[1].Float("pl_rade"); ok {
+			t.Errorf("Float() [%v] is ok, want null", format)
+		}
+		if s := res.Rows[1].String("disc_year"); s != "2020" {
+			t.Errorf("String() [%v] is \"%v\", want \"2020\"", format, s)
+		}
+		buf := &bytes.Buffer{}
+		if err := res.WriteCSV(buf); err != nil {
+			t.Errorf("WriteCSV() [%v] is \"%v\", want nil", format, err)
+		} else if want := "pl_name,disc_year,pl_rade\nKepler-22 b,2011,2.1\nTOI-700 d,2020,\n"; buf.String() != want {
+			t.Errorf("WriteCSV() [%v] is \"%v\", want \"%v\"", format, buf.String(), want)
+		}
+	}
+
+	var apiErr *nasaapi.APIError
+	if _, err := New(WithQuery("select * from foo"), WithBaseURL(u), WithClient(cli)).Do(context.Background()); !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusBadRequest {
+		t.Errorf("Do() is \"%v\", want HTTP 400 error", err)
+	}
+	if _, err := New(WithBaseURL(u), WithClient(cli)).Do(context.Background()); !errors.Is(err, ecode.ErrInvalidParameter) {
+		t.Errorf("Do() is \"%v\", want \"%v\"", err, ecode.ErrInvalidParameter)
+	}
+}
+
+func TestDecodeJSON(t *testing.T) {
+	testCases := []struct {
+		s    string
+		rows int
+		err  bool
+	}{
+		{s: `[]`, rows: 0},
+		{s: `[{"a":1,"b":"x"}]`, rows: 1},
+		{s: `{"a":1}`, err: true},
+		{s: `[{"a":1}`, err: true},
+	}
+	for _, tc := range testCases {
+		res, err := DecodeJSON(strings.NewReader(tc.s))
+		if (err != nil) != tc.err {
+			t.Errorf("DecodeJSON(%q) error is \"%v\", want error %v", tc.s, err, tc.err)
+		} else if err == nil && len(res.Rows) != tc.rows {
+			t.Errorf("DecodeJSON(%q) is %v, want %d rows", tc.s, res.Rows, tc.rows)
+		}
+	}
+}
+
+/* MIT License
+ *
+ * Copyright 2023 Spiegel
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
@@ -0,0 +1,178 @@
+package exoplanet
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/goark/apod/ecode"
+	"github.com/goark/errs"
+)
+
+// Result is result of TAP query. Rows have dynamic columns given by ADQL query.
+type Result struct {
+	Columns []string `json:"columns"` // column names in order of the result
+	Rows    []Row    `json:"rows"`
+}
+
+// Row is a record of TAP query result.
+// Values are string, json.Number, bool or nil (null or empty value).
+type Row map[string]interface{}
+
+// String method returns value of the column as string. Returns empty string if the value is null.
+func (r Row) String(col string) string {
+	switch v := r[col].(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+// Float method returns value of the column as float64. Returns false if the value is null or not a number.
+func (r Row) Float(col string) (float64, bool) {
+	switch v := r[col].(type) {
+	case json.Number:
+		f, err := v.Float64()
+		return f, err == nil
+	case string:
+		f, err := strconv.ParseFloat(v, 64)
+		return f, err == nil
+	default:
+		return 0, false
+	}
+}
+
+// DecodeCSV function decodes TAP query result in CSV format (with header record).
+func DecodeCSV(r io.Reader) (*Result, error) {
+	cr := csv.NewReader(r)
+	cr.FieldsPerRecord = -1
+	records, err := cr.ReadAll()
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	res := &Result{Columns: []string{}, Rows: []Row{}}
+	if len(records) == 0 {
+		return res, nil
+	}
+	res.Columns = records[0]
+	for _, record := range records[1:] {
+		row := Row{}
+		for i, col := range res.Columns {
+			if i < len(record) && len(record[i]) > 0 {
+				row[col] = record[i]
+			} else {
+				row[col] = nil
+			}
+		}
+		res.Rows = append(res.Rows, row)
+	}
+	return res, nil
+}
+
+// DecodeJSON function decodes TAP query result in JSON format (array of objects).
+// Order of columns is taken from the first object.
+func DecodeJSON(r io.Reader) (*Result, error) {
+	dec := json.NewDecoder(r)
+	dec.UseNumber()
+	if err := expectDelim(dec, '['); err != nil {
+		return nil, errs.Wrap(err)
+	}
+	res := &Result{Columns: []string{}, Rows: []Row{}}
+	for dec.More() {
+		if err := expectDelim(dec, '{'); err != nil {
+			return nil, errs.Wrap(err)
+		}
+		row := Row{}
+		first := len(res.Rows) == 0
+		for dec.More() {
+			tok, err := dec.Token()
+			if err != nil {
+				return nil, errs.Wrap(err)
+			}
+			col, ok := tok.(string)
+			if !ok {
+				return nil, errs.Wrap(ecode.ErrInvalidParameter, errs.WithContext("token", tok))
+			}
+			var v interface{}
+			if err := dec.Decode(&v); err != nil {
+				return nil, errs.Wrap(err, errs.WithContext("column", col))
+			}
+			if s, ok := v.(string); ok && len(s) == 0 {
+				v = nil
+			}
+			row[col] = v
+			if first {
+				res.Columns = append(res.Columns, col)
+			}
+		}
+		if err := expectDelim(dec, '}'); err != nil {
+			return nil, errs.Wrap(err)
+		}
+		res.Rows = append(res.Rows, row)
+	}
+	if err := expectDelim(dec, ']'); err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return res, nil
+}
+
+func expectDelim(dec *json.Decoder, delim json.Delim) error {
+	tok, err := dec.Token()
+	if err != nil {
+		return errs.Wrap(err)
+	}
+	if d, ok := tok.(json.Delim); !ok || d != delim {
+		return errs.Wrap(ecode.ErrInvalidParameter, errs.WithContext("token", tok), errs.WithContext("want", delim.String()))
+	}
+	return nil
+}
+
+// WriteCSV method outputs result in CSV format.
+func (res *Result) WriteCSV(w io.Writer) error {
+	if res == nil {
+		return errs.Wrap(ecode.ErrNullPointer)
+	}
+	cw := csv.NewWriter(w)
+	if err := cw.Write(res.Columns); err != nil {
+		return errs.Wrap(err)
+	}
+	for _, row := range res.Rows {
+		record := make([]string, len(res.Columns))
+		for i, col := range res.Columns {
+			record[i] = row.String(col)
+		}
+		if err := cw.Write(record); err != nil {
+			return errs.Wrap(err)
+		}
+	}
+	cw.Flush()
+	return errs.Wrap(cw.Error())
+}
+
+/* MIT License
+ *
+ * Copyright 2023 Spiegel
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
@@ -0,0 +1,319 @@
+package images
+
+import (
+	"context"
+	"net/url"
+	"path"
+	"strconv"
+	"strings"
+
+	"github.com/goark/apod/ecode"
+	"github.com/goark/apod/nasaapi"
+	"github.com/goark/errs"
+)
+
+const (
+	DefaultBaseURL = "https://images-api.nasa.gov" // Default base URL of NASA Image and Video Library API
+
+	SearchPath   = "/search"
+	AssetPath    = "/asset"
+	MetadataPath = "/metadata"
+	CaptionsPath = "/captions"
+)
+
+// Request is for context of NASA Image and Video Library API.
+type Request struct {
+	Query     string   `json:"q,omitempty"`          // Free text search terms
+	NasaID    string   `json:"nasa_id,omitempty"`    // NASA ID
+	MediaType []string `json:"media_type,omitempty"` // Media types (image, video, audio)
+	Center    string   `json:"center,omitempty"`     // NASA center which published the media
+	Keywords  []string `json:"keywords,omitempty"`   // Terms to search for in keywords
+	YearStart int      `json:"year_start,omitempty"` // Start year of date_created
+	YearEnd   int      `json:"year_end,omitempty"`   // End year of date_created
+	Page      int      `json:"page,omitempty"`       // Page number (1 origin)
+	PageSize  int      `json:"page_size,omitempty"`  // Number of results per page (default is 100)
+	baseURL   *url.URL
+	client    *nasaapi.Client
+	cli       *nasaapi.Client // client for base URL (made by New function)
+}
+
+type Opts func(*Request)
+
+// New returns new Request instance for NASA Image and Video Library API.
+func New(opts ...Opts) *Request {
+	ctx := &Request{}
+	for _, opt := range opts {
+		opt(ctx)
+	}
+	ctx.cli = ctx.newClient()
+	return ctx
+}
+
+// WithQuery returns function for setting Request.Query.
+func WithQuery(q string) Opts {
+	return func(ctx *Request) {
+		if ctx != nil {
+			ctx.Query = q
+		}
+	}
+}
+
+// WithNasaID returns function for setting Request.NasaID.
+func WithNasaID(id string) Opts {
+	return func(ctx *Request) {
+		if ctx != nil {
+			ctx.NasaID = id
+		}
+	}
+}
+
+// WithMediaType returns function for setting Request.MediaType.
+func WithMediaType(types ...string) Opts {
+	return func(ctx *Request) {
+		if ctx != nil {
+			ctx.MediaType = types
+		}
+	}
+}
+
+// WithCenter returns function for setting Request.Center.
+func WithCenter(center string) Opts {
+	return func(ctx *Request) {
+		if ctx != nil {
+			ctx.Center = center
+		}
+	}
+}
+
+// WithKeywords returns function for setting Request.Keywords.
+func WithKeywords(keywords ...string) Opts {
+	return func(ctx *Request) {
+		if ctx != nil {
+			ctx.Keywords = keywords
+		}
+	}
+}
+
+// WithYears returns function for setting Request.YearStart and Request.YearEnd (0 is not set).
+func WithYears(start, end int) Opts {
+	return func(ctx *Request) {
+		if ctx != nil {
+			ctx.YearStart = start
+			ctx.YearEnd = end
+		}
+	}
+}
+
+// WithPage returns function for setting Request.Page.
+func WithPage(page int) Opts {
+	return func(ctx *Request) {
+		if ctx != nil {
+			ctx.Page = page
+		}
+	}
+}
+
+// WithPageSize returns function for setting Request.PageSize.
+func WithPageSize(size int) Opts {
+	return func(ctx *Request) {
+		if ctx != nil {
+			ctx.PageSize = size
+		}
+	}
+}
+
+// WithBaseURL returns function for setting base URL of NASA Image and Video Library API.
+func WithBaseURL(u *url.URL) Opts {
+	return func(ctx *Request) {
+		if ctx != nil {
+			ctx.baseURL = u
+		}
+	}
+}
+
+// WithClient returns function for setting nasaapi.Client.
+// Base URL of the client is replaced by DefaultBaseURL (or WithBaseURL option).
+func WithClient(client *nasaapi.Client) Opts {
+	return func(ctx *Request) {
+		if ctx != nil {
+			ctx.client = client
+		}
+	}
+}
+
+// Client method returns nasaapi.Client instance for requesting NASA Image and Video Library API.
+// It returns the same instance for each call, so rate limit information of the last request is available.
+func (req *Request) Client() *nasaapi.Client {
+	if req != nil && req.cli != nil {
+		return req.cli
+	}
+	return req.newClient()
+}
+
+func (req *Request) newClient() *nasaapi.Client {
+	var cli *nasaapi.Client
+	if req != nil && req.client != nil {
+		cli = req.client
+	} else {
+		cli = nasaapi.DefaultClient()
+	}
+	return cli.Clone(nasaapi.WithBaseURL(req.BaseURL()))
+}
+
+// BaseURL method returns base URL of NASA Image and Video Library API.
+func (req *Request) BaseURL() *url.URL {
+	if req == nil || req.baseURL == nil {
+		u, _ := url.Parse(DefaultBaseURL)
+		return u
+	}
+	u := *req.baseURL
+	return &u
+}
+
+// Search method searches media in NASA Image and Video Library.
+func (req *Request) Search(ctx context.Context) (*Collection, error) {
+	if req == nil {
+		return nil, errs.Wrap(ecode.ErrNullPointer)
+	}
+	q, err := req.makeQuery()
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return req.getCollection(ctx, SearchPath, q)
+}
+
+// SearchAll method searches media page by page from Request.Page (or first page), and calls fn function for each page.
+// If fn returns false, paging is stopped.
+func (req *Request) SearchAll(ctx context.Context, fn func(*Collection) bool) error {
+	if req == nil {
+		return errs.Wrap(ecode.ErrNullPointer)
+	}
+	r := *req
+	if r.Page < 1 {
+		r.Page = 1
+	}
+	for {
+		c, err := r.Search(ctx)
+		if err != nil {
+			return errs.Wrap(err, errs.WithContext("page", r.Page))
+		}
+		if !fn(c) || !c.HasNext() {
+			return nil
+		}
+		r.Page++
+	}
+}
+
+// Asset method gets list of asset files for the NASA ID.
+func (req *Request) Asset(ctx context.Context, nasaID string) (*Collection, error) {
+	if req == nil {
+		return nil, errs.Wrap(ecode.ErrNullPointer)
+	}
+	if err := validateID(nasaID); err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return req.getCollection(ctx, path.Join(AssetPath, nasaID), url.Values{})
+}
+
+// Metadata method gets location of metadata file for the NASA ID.
+func (req *Request) Metadata(ctx context.Context, nasaID string) (*Location, error) {
+	return req.getLocation(ctx, MetadataPath, nasaID)
+}
+
+// Captions method gets location of captions file for the NASA ID (video only).
+func (req *Request) Captions(ctx context.Context, nasaID string) (*Location, error) {
+	return req.getLocation(ctx, CaptionsPath, nasaID)
+}
+
+func (req *Request) getCollection(ctx context.Context, p string, q url.Values) (*Collection, error) {
+	var res Result
+	if err := req.Client().RequestJSON(ctx, p, q, &res); err != nil {
+		return nil, errs.Wrap(err)
+	}
+	if res.Collection == nil {
+		return &Collection{}, nil
+	}
+	return res.Collection, nil
+}
+
+func (req *Request) getLocation(ctx context.Context, p, nasaID string) (*Location, error) {
+	if req == nil {
+		return nil, errs.Wrap(ecode.ErrNullPointer)
+	}
+	if err := validateID(nasaID); err != nil {
+		return nil, errs.Wrap(err)
+	}
+	var loc Location
+	if err := req.Client().RequestJSON(ctx, path.Join(p, nasaID), url.Values{}, &loc); err != nil {
+		return nil, errs.Wrap(err, errs.WithContext("nasa_id", nasaID))
+	}
+	return &loc, nil
+}
+
+func validateID(nasaID string) error {
+	if len(nasaID) == 0 || strings.ContainsAny(nasaID, "/?#") || nasaID == "." || nasaID == ".." {
+		return errs.Wrap(ecode.ErrInvalidParameter, errs.WithContext("nasa_id", nasaID))
+	}
+	return nil
+}
+
+func (req *Request) makeQuery() (url.Values, error) {
+	q := url.Values{}
+	if len(req.Query) > 0 {
+		q.Set("q", req.Query)
+	}
+	if len(req.NasaID) > 0 {
+		q.Set("nasa_id", req.NasaID)
+	}
+	if len(req.MediaType) > 0 {
+		q.Set("media_type", strings.Join(req.MediaType, ","))
+	}
+	if len(req.Center) > 0 {
+		q.Set("center", req.Center)
+	}
+	if len(req.Keywords) > 0 {
+		q.Set("keywords", strings.Join(req.Keywords, ","))
+	}
+	if req.YearStart > 0 {
+		q.Set("year_start", strconv.Itoa(req.YearStart))
+	}
+	if req.YearEnd > 0 {
+		q.Set("year_end", strconv.Itoa(req.YearEnd))
+	}
+	if req.YearStart > 0 && req.YearEnd > 0 && req.YearEnd < req.YearStart {
+		return nil, errs.Wrap(ecode.ErrEndBeforeStart, errs.WithContext("config", req))
+	}
+	if len(q) == 0 {
+		return nil, errs.Wrap(ecode.ErrInvalidParameter, errs.WithContext("config", req))
+	}
+	if req.Page > 0 {
+		q.Set("page", strconv.Itoa(req.Page))
+	}
+	if req.PageSize > 0 {
+		q.Set("page_size", strconv.Itoa(req.PageSize))
+	}
+	return q, nil
+}
+
+/* MIT License
+ *
+ * Copyright 2023 Spiegel
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
@@ -0,0 +1,133 @@
+package images
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/goark/apod/ecode"
+	"github.com/goark/apod/internal/testutil"
+	"github.com/goark/apod/nasaapi"
+)
+
+func TestMakeQuery(t *testing.T) {
+	testCases := []struct {
+		req *Request
+		q   string
+		err error
+	}{
+		{req: New(WithQuery("apollo 11"), WithMediaType("image", "video"), WithYears(1969, 1970), WithPage(2)), q: "media_type=image%2Cvideo&page=2&q=apollo+11&year_end=1970&year_start=1969", err: nil},
+		{req: New(WithNasaID("as11-40-5874")), q: "nasa_id=as11-40-5874", err: nil},
+		{req: New(WithPage(2)), err: ecode.ErrInvalidParameter},
+		{req: New(WithQuery("apollo"), WithYears(1970, 1969)), err: ecode.ErrEndBeforeStart},
+	}
+
+	for _, tc := range testCases {
+		q, err := tc.req.makeQuery()
+		if !errors.Is(err, tc.err) {
+			t.Errorf("makeQuery() is \"%v\", want \"%v\"", err, tc.err)
+		} else if err == nil && q.Encode() != tc.q {
+			t.Errorf("makeQuery() is \"%v\", want \"%v\"", q.Encode(), tc.q)
+		}
+	}
+}
+
+func TestSearch(t *testing.T) {
+	var queryList []string
+	u, _ := testutil.NewServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set(nasaapi.HeaderRateLimitLimit, "1000")
+		w.Header().Set(nasaapi.HeaderRateLimitRemaining, "999")
+		switch r.URL.Path {
+		case SearchPath:
+			queryList = append(queryList, r.URL.RawQuery)
+			if r.URL.Query().Get("page") == "1" {
+				_, _ = w.Write([]byte(`{"collection":{"version":"1.0","items":[{"href":"https://images-assets.nasa.gov/image/as11-40-5874/collection.json","data":[{"nasa_id":"as11-40-5874","title":"Apollo 11","media_type":"image","date_created":"1969-07-20T00:00:00Z"}]}],"metadata":{"total_hits":2},"links":[{"rel":"next","prompt":"Next","href":"https://images-api.nasa.gov/search?q=apollo&page=2"}]}}`))
+				return
+			}
+			_, _ = w.Write([]byte(`{"collection":{"version":"1.0","items":[{"href":"https://images-assets.nasa.gov/image/as11-40-5875/collection.json","data":[{"nasa_id":"as11-40-5875","title":"Apollo 11","media_type":"image","date_created":"1969-07-20T00:00:00Z"}]}],"metadata":{"total_hits":2},"links":[{"rel":"prev","prompt":"Previous","href":"https://images-api.nasa.gov/search?q=apollo&page=1"}]}}`))
+		case AssetPath + "/as11-40-5874":
+			_, _ = w.Write([]byte(`{"collection":{"version":"1.0","items":[{"href":"https://images-assets.nasa.gov/image/as11-40-5874/as11-40-5874~orig.jpg"},{"href":"https://images-assets.nasa.gov/image/as11-40-5874/as11-40-5874~thumb.jpg"},{"href":"https://images-assets.nasa.gov/image/as11-40-5874/metadata.json"}]}}`))
+		case MetadataPath + "/as11-40-5874":
+			_, _ = w.Write([]byte(`{"location":"https://images-assets.nasa.gov/image/as11-40-5874/metadata.json"}`))
+		default:
+			http.Error(w, `{"reason":"The server could not find the requested resource."}`, http.StatusNotFound)
+		}
+	})
+	// base URL of nasaapi.Client is not used
+	req := New(WithQuery("apollo"), WithMediaType("image"), WithBaseURL(u), WithClient(nasaapi.NewClient(nasaapi.WithKeyLimiter(nil))))
+
+	var ids []string
+	if err := req.SearchAll(context.Background(), func(c *Collection) bool {
+		for _, item := range c.Items {
+			ids = append(ids, item.Data[0].NasaID)
+		}
+		return true
+	}); err != nil {
+		t.Fatalf("SearchAll() is \"%v\", want nil", err)
+	}
+	if len(ids) != 2 || ids[0] != "as11-40-5874" || ids[1] != "as11-40-5875" {
+		t.Errorf("SearchAll() ids are %v, want [as11-40-5874 as11-40-5875]", ids)
+	}
+	if want := "media_type=image&page=1&q=apollo,media_type=image&page=2&q=apollo"; strings.Join(queryList, ",") != want {
+		t.Errorf("queries of SearchAll() are %v, want %v", queryList, want)
+	}
+	if rl, ok := req.Client().RateLimit(); !ok || rl.Remaining != 999 {
+		t.Errorf("Client().RateLimit() is (%v, %v), want remaining 999", rl, ok)
+	}
+
+	asset, err := req.Asset(context.Background(), "as11-40-5874")
+	if err != nil {
+		t.Fatalf("Asset() is \"%v\", want nil", err)
+	}
+	testCases := []struct {
+		size string
+		want string
+	}{
+		{size: "orig", want: "https://images-assets.nasa.gov/image/as11-40-5874/as11-40-5874~orig.jpg"},
+		{size: "thumb", want: "https://images-assets.nasa.gov/image/as11-40-5874/as11-40-5874~thumb.jpg"},
+		{size: "large", want: "https://images-assets.nasa.gov/image/as11-40-5874/as11-40-5874~orig.jpg"},
+	}
+	for _, tc := range testCases {
+		if got := SelectAsset(asset.Hrefs(), tc.size); got != tc.want {
+			t.Errorf("SelectAsset(%v) is \"%v\", want \"%v\"", tc.size, got, tc.want)
+		}
+	}
+
+	loc, err := req.Metadata(context.Background(), "as11-40-5874")
+	if err != nil {
+		t.Errorf("Metadata() is \"%v\", want nil", err)
+	} else if loc.Location != "https://images-assets.nasa.gov/image/as11-40-5874/metadata.json" {
+		t.Errorf("Metadata() is \"%v\"", loc.Location)
+	}
+	if _, err := req.Metadata(context.Background(), "unknown"); !errors.Is(err, ecode.ErrNotFound) {
+		t.Errorf("Metadata() is \"%v\", want \"%v\"", err, ecode.ErrNotFound)
+	}
+	if _, err := req.Captions(context.Background(), "../search"); !errors.Is(err, ecode.ErrInvalidParameter) {
+		t.Errorf("Captions() is \"%v\", want \"%v\"", err, ecode.ErrInvalidParameter)
+	}
+}
+
+/* MIT License
+ *
+ * Copyright 2023 Spiegel
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
@@ -0,0 +1,134 @@
+package images
+
+import (
+	"strings"
+	"time"
+)
+
+// Result is response data (Collection+JSON) from NASA Image and Video Library API.
+type Result struct {
+	Collection *Collection `json:"collection"`
+}
+
+// Collection is Collection+JSON collection.
+type Collection struct {
+	Version  string    `json:"version"`
+	Href     string    `json:"href"`
+	Items    []*Item   `json:"items"`
+	Metadata *Metadata `json:"metadata,omitempty"`
+	Links    []*Link   `json:"links,omitempty"`
+}
+
+// Metadata is metadata of search result.
+type Metadata struct {
+	TotalHits int `json:"total_hits"`
+}
+
+// Item is item of Collection+JSON collection.
+type Item struct {
+	Href  string      `json:"href"`
+	Data  []*ItemData `json:"data,omitempty"`
+	Links []*Link     `json:"links,omitempty"`
+}
+
+// ItemData is data of search result item.
+type ItemData struct {
+	NasaID           string    `json:"nasa_id"`
+	Title            string    `json:"title"`
+	MediaType        string    `json:"media_type"`
+	DateCreated      time.Time `json:"date_created"`
+	Center           string    `json:"center,omitempty"`
+	Description      string    `json:"description,omitempty"`
+	Description508   string    `json:"description_508,omitempty"`
+	Keywords         []string  `json:"keywords,omitempty"`
+	Location         string    `json:"location,omitempty"`
+	Photographer     string    `json:"photographer,omitempty"`
+	SecondaryCreator string    `json:"secondary_creator,omitempty"`
+	Album            []string  `json:"album,omitempty"`
+}
+
+// Link is link of Collection+JSON.
+type Link struct {
+	Href   string `json:"href"`
+	Rel    string `json:"rel"`
+	Prompt string `json:"prompt,omitempty"`
+	Render string `json:"render,omitempty"`
+}
+
+// Location is response data from /metadata/{nasa_id} and /captions/{nasa_id}.
+type Location struct {
+	Location string `json:"location"`
+}
+
+// Link method returns link with the relation.
+func (c *Collection) Link(rel string) *Link {
+	if c == nil {
+		return nil
+	}
+	for _, l := range c.Links {
+		if l != nil && l.Rel == rel {
+			return l
+		}
+	}
+	return nil
+}
+
+// HasNext method reports whether next page exists.
+func (c *Collection) HasNext() bool {
+	return c.Link("next") != nil
+}
+
+// Hrefs method returns list of Item.Href.
+func (c *Collection) Hrefs() []string {
+	if c == nil {
+		return nil
+	}
+	hrefs := make([]string, 0, len(c.Items))
+	for _, item := range c.Items {
+		if item != nil && len(item.Href) > 0 {
+			hrefs = append(hrefs, item.Href)
+		}
+	}
+	return hrefs
+}
+
+// SelectAsset returns URL of asset file with the size (e.g. "orig", "large", "medium", "small", "thumb").
+// If it is not found, returns first asset URL except metadata.json.
+func SelectAsset(hrefs []string, size string) string {
+	first := ""
+	for _, href := range hrefs {
+		if strings.HasSuffix(href, "/metadata.json") || strings.HasSuffix(href, ".srt") || strings.HasSuffix(href, ".vtt") {
+			continue
+		}
+		if strings.Contains(href, "~"+size+".") {
+			return href
+		}
+		if len(first) == 0 {
+			first = href
+		}
+	}
+	return first
+}
+
+/* MIT License
+ *
+ * Copyright 2023 Spiegel
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
@@ -0,0 +1,176 @@
+package nasaapi
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/goark/errs"
+)
+
+const (
+	DemoKeyLimit       = 30   // requests per hour for DEMO_KEY
+	RegisteredKeyLimit = 1000 // requests per hour for registered API key
+)
+
+// Limiter is token bucket rate limiter.
+type Limiter struct {
+	mutex  sync.Mutex
+	rate   float64 // tokens per second
+	burst  float64 // capacity of bucket
+	tokens float64
+	last   time.Time
+}
+
+// NewLimiter returns Limiter instance which allows burst requests at once,
+// and does not exceed limit requests in any window of period.
+func NewLimiter(limit int, period time.Duration, burst int) *Limiter {
+	if limit < 1 {
+		limit = 1
+	}
+	if burst >= limit {
+		burst = limit - 1
+	}
+	if burst < 1 {
+		burst = 1
+	}
+	rate := float64(limit-burst) / period.Seconds()
+	if rate <= 0 {
+		rate = float64(limit) / period.Seconds()
+	}
+	return &Limiter{
+		rate:   rate,
+		burst:  float64(burst),
+		tokens: float64(burst),
+		last:   time.Now(),
+	}
+}
+
+// Wait method blocks until a request is allowed or ctx is done.
+func (l *Limiter) Wait(ctx context.Context) error {
+	if l == nil {
+		return nil
+	}
+	l.mutex.Lock()
+	l.refill(time.Now())
+	l.tokens--
+	var wait time.Duration
+	if l.tokens < 0 {
+		wait = time.Duration(-l.tokens / l.rate * float64(time.Second))
+	}
+	l.mutex.Unlock()
+	if wait <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(wait)
+	select {
+	case <-ctx.Done():
+		timer.Stop()
+		l.mutex.Lock()
+		l.refill(time.Now())
+		l.tokens++
+		if l.tokens > l.burst {
+			l.tokens = l.burst
+		}
+		l.mutex.Unlock()
+		return errs.Wrap(ctx.Err())
+	case <-timer.C:
+		return nil
+	}
+}
+
+func (l *Limiter) refill(now time.Time) {
+	if elapsed := now.Sub(l.last).Seconds(); elapsed > 0 {
+		l.tokens += elapsed * l.rate
+		if l.tokens > l.burst {
+			l.tokens = l.burst
+		}
+	}
+	l.last = now
+}
+
+// KeyLimiter is set of Limiter instances per API key.
+type KeyLimiter struct {
+	mutex    sync.Mutex
+	limiters map[string]*Limiter
+}
+
+var defaultKeyLimiter = NewKeyLimiter()
+
+// DefaultKeyLimiter returns default KeyLimiter instance shared by Client instances.
+func DefaultKeyLimiter() *KeyLimiter {
+	return defaultKeyLimiter
+}
+
+// NewKeyLimiter returns new KeyLimiter instance.
+func NewKeyLimiter() *KeyLimiter {
+	return &KeyLimiter{limiters: map[string]*Limiter{}}
+}
+
+// Set method sets Limiter for the API key. If l is nil, requests with the API key are not limited.
+func (kl *KeyLimiter) Set(apiKey string, l *Limiter) {
+	if kl == nil {
+		return
+	}
+	kl.mutex.Lock()
+	defer kl.mutex.Unlock()
+	kl.limiters[apiKey] = l
+}
+
+// Get method returns Limiter for the API key. If not set, returns Limiter with known limit of NASA API.
+func (kl *KeyLimiter) Get(apiKey string) *Limiter {
+	if kl == nil {
+		return nil
+	}
+	kl.mutex.Lock()
+	defer kl.mutex.Unlock()
+	if l, ok := kl.limiters[apiKey]; ok {
+		return l
+	}
+	var l *Limiter
+	if apiKey == DefaultAPIKey {
+		l = NewLimiter(DemoKeyLimit, time.Hour, DemoKeyLimit/6)
+	} else {
+		l = NewLimiter(RegisteredKeyLimit, time.Hour, RegisteredKeyLimit/10)
+	}
+	kl.limiters[apiKey] = l
+	return l
+}
+
+// Wait method blocks until a request with the API key is allowed or ctx is done.
+func (kl *KeyLimiter) Wait(ctx context.Context, apiKey string) error {
+	return kl.Get(apiKey).Wait(ctx)
+}
+
+// WithKeyLimiter returns function for setting KeyLimiter. If kl is nil, requests are not limited.
+func WithKeyLimiter(kl *KeyLimiter) ClientOpts {
+	return func(c *Client) {
+		if c != nil {
+			c.limiter = kl
+		}
+	}
+}
+
+/* MIT License
+ *
+ * Copyright 2023 Spiegel
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
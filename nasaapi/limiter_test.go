@@ -0,0 +1,66 @@
+package nasaapi
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestLimiter(t *testing.T) {
+	l := NewLimiter(3, 300*time.Millisecond, 1) // 1 burst, and 1 token per 150ms
+	start := time.Now()
+	for i := 0; i < 3; i++ {
+		if err := l.Wait(context.Background()); err != nil {
+			t.Fatalf("Limiter.Wait() is \"%v\", want nil", err)
+		}
+	}
+	if d := time.Since(start); d < 250*time.Millisecond {
+		t.Errorf("3 requests are allowed in %v, want >= 300ms - burst", d)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if err := l.Wait(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Limiter.Wait() is \"%v\", want \"%v\"", err, context.DeadlineExceeded)
+	}
+}
+
+func TestKeyLimiter(t *testing.T) {
+	kl := NewKeyLimiter()
+	if kl.Get(DefaultAPIKey) == kl.Get("foo") {
+		t.Error("KeyLimiter.Get() returns same Limiter for different keys")
+	}
+	if kl.Get("foo") != kl.Get("foo") {
+		t.Error("KeyLimiter.Get() returns different Limiter for same key")
+	}
+	kl.Set("bar", nil)
+	for i := 0; i < 100; i++ {
+		if err := kl.Wait(context.Background(), "bar"); err != nil {
+			t.Fatalf("KeyLimiter.Wait() is \"%v\", want nil", err)
+		}
+	}
+}
+
+/* MIT License
+ *
+ * Copyright 2023 Spiegel
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
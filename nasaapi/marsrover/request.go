@@ -0,0 +1,276 @@
+package marsrover
+
+import (
+	"context"
+	"net/url"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/goark/apod/ecode"
+	"github.com/goark/apod/nasaapi"
+	"github.com/goark/errs"
+)
+
+const (
+	RoversPath    = "/mars-photos/api/v1/rovers"
+	ManifestsPath = "/mars-photos/api/v1/manifests"
+
+	PageSize = 25 // Number of photos per page
+)
+
+// Rover is name of Mars rover.
+type Rover string
+
+const (
+	Curiosity    Rover = "curiosity"
+	Opportunity  Rover = "opportunity"
+	Spirit       Rover = "spirit"
+	Perseverance Rover = "perseverance"
+)
+
+// Rovers is list of Mars rovers.
+var Rovers = []Rover{Curiosity, Opportunity, Spirit, Perseverance}
+
+// RoverFrom returns Rover from string (case insensitive). Empty string is Curiosity.
+func RoverFrom(s string) (Rover, error) {
+	if len(s) == 0 {
+		return Curiosity, nil
+	}
+	for _, r := range Rovers {
+		if strings.EqualFold(string(r), s) {
+			return r, nil
+		}
+	}
+	return "", errs.Wrap(ecode.ErrInvalidParameter, errs.WithContext("rover", s))
+}
+
+// Request is for context of Mars Rover Photos API.
+type Request struct {
+	Rover     Rover        `json:"rover"`                // Name of rover (default is curiosity)
+	Sol       int          `json:"sol,omitempty"`        // Martian sol of the rover's mission
+	EarthDate nasaapi.Date `json:"earth_date,omitempty"` // Earth date (cannot be used with Sol)
+	Camera    string       `json:"camera,omitempty"`     // Camera abbreviation (e.g. FHAZ, NAVCAM)
+	Page      int          `json:"page,omitempty"`       // Page number (1 origin, 0 is all photos)
+	APIKey    string       `json:"api_key"`              // api.nasa.gov key for expanded usage
+	solFlag   bool
+	client    *nasaapi.Client
+}
+
+type Opts func(*Request)
+
+// New returns new Request instance for Mars Rover Photos API.
+func New(opts ...Opts) *Request {
+	ctx := &Request{Rover: Curiosity}
+	for _, opt := range opts {
+		opt(ctx)
+	}
+	return ctx
+}
+
+// WithRover returns function for setting Request.Rover.
+func WithRover(rover Rover) Opts {
+	return func(ctx *Request) {
+		if ctx != nil {
+			ctx.Rover = rover
+		}
+	}
+}
+
+// WithSol returns function for setting Request.Sol.
+func WithSol(sol int) Opts {
+	return func(ctx *Request) {
+		if ctx != nil {
+			ctx.Sol = sol
+			ctx.solFlag = true
+		}
+	}
+}
+
+// WithEarthDate returns function for setting Request.EarthDate.
+func WithEarthDate(date nasaapi.Date) Opts {
+	return func(ctx *Request) {
+		if ctx != nil {
+			ctx.EarthDate = date
+		}
+	}
+}
+
+// WithCamera returns function for setting Request.Camera.
+func WithCamera(camera string) Opts {
+	return func(ctx *Request) {
+		if ctx != nil {
+			ctx.Camera = camera
+		}
+	}
+}
+
+// WithPage returns function for setting Request.Page.
+func WithPage(page int) Opts {
+	return func(ctx *Request) {
+		if ctx != nil {
+			ctx.Page = page
+		}
+	}
+}
+
+// WithAPIKey returns function for setting Request.APIKey.
+func WithAPIKey(apiKey string) Opts {
+	return func(ctx *Request) {
+		if ctx != nil {
+			ctx.APIKey = apiKey
+		}
+	}
+}
+
+// WithClient returns function for setting nasaapi.Client.
+func WithClient(client *nasaapi.Client) Opts {
+	return func(ctx *Request) {
+		if ctx != nil {
+			ctx.client = client
+		}
+	}
+}
+
+// Client method returns nasaapi.Client instance for requesting. If not set, returns nasaapi.DefaultClient().
+func (req *Request) Client() *nasaapi.Client {
+	if req == nil || req.client == nil {
+		return nasaapi.DefaultClient()
+	}
+	return req.client
+}
+
+// Photos method gets photos by sol or earth date. If neither is set, gets latest photos.
+func (req *Request) Photos(ctx context.Context) ([]*Photo, error) {
+	if req == nil {
+		return nil, errs.Wrap(ecode.ErrNullPointer)
+	}
+	if !req.solFlag && req.EarthDate.IsZero() {
+		return req.LatestPhotos(ctx)
+	}
+	q, err := req.makeQuery()
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	var resp photosResponse
+	if err := req.Client().RequestJSON(ctx, path.Join(RoversPath, string(req.rover()), "photos"), q, &resp); err != nil {
+		return nil, errs.Wrap(err)
+	}
+	if resp.Photos == nil {
+		return []*Photo{}, nil
+	}
+	return resp.Photos, nil
+}
+
+// AllPages method gets photos page by page from Request.Page (or first page), and calls fn function for each page.
+// If fn returns false, paging is stopped.
+func (req *Request) AllPages(ctx context.Context, fn func(page int, photos []*Photo) bool) error {
+	if req == nil {
+		return errs.Wrap(ecode.ErrNullPointer)
+	}
+	r := *req
+	if r.Page < 1 {
+		r.Page = 1
+	}
+	for {
+		photos, err := r.Photos(ctx)
+		if err != nil {
+			return errs.Wrap(err, errs.WithContext("page", r.Page))
+		}
+		if len(photos) == 0 || !fn(r.Page, photos) || len(photos) < PageSize {
+			return nil
+		}
+		r.Page++
+	}
+}
+
+// LatestPhotos method gets photos of the most recent sol.
+func (req *Request) LatestPhotos(ctx context.Context) ([]*Photo, error) {
+	if req == nil {
+		return nil, errs.Wrap(ecode.ErrNullPointer)
+	}
+	q := req.query()
+	if len(req.Camera) > 0 {
+		q.Set("camera", strings.ToLower(req.Camera))
+	}
+	var resp latestPhotosResponse
+	if err := req.Client().RequestJSON(ctx, path.Join(RoversPath, string(req.rover()), "latest_photos"), q, &resp); err != nil {
+		return nil, errs.Wrap(err)
+	}
+	if resp.LatestPhotos == nil {
+		return []*Photo{}, nil
+	}
+	return resp.LatestPhotos, nil
+}
+
+// Manifest method gets mission manifest of the rover.
+func (req *Request) Manifest(ctx context.Context) (*Manifest, error) {
+	if req == nil {
+		return nil, errs.Wrap(ecode.ErrNullPointer)
+	}
+	var resp manifestResponse
+	if err := req.Client().RequestJSON(ctx, path.Join(ManifestsPath, string(req.rover())), req.query(), &resp); err != nil {
+		return nil, errs.Wrap(err)
+	}
+	if resp.PhotoManifest == nil {
+		return nil, errs.Wrap(ecode.ErrNotFound, errs.WithContext("rover", req.rover()))
+	}
+	return resp.PhotoManifest, nil
+}
+
+func (req *Request) rover() Rover {
+	if len(req.Rover) == 0 {
+		return Curiosity
+	}
+	return req.Rover
+}
+
+func (req *Request) makeQuery() (url.Values, error) {
+	if req.solFlag && !req.EarthDate.IsZero() {
+		return nil, errs.Wrap(ecode.ErrCombination, errs.WithContext("config", req))
+	}
+	q := req.query()
+	if req.solFlag {
+		q.Set("sol", strconv.Itoa(req.Sol))
+	}
+	if !req.EarthDate.IsZero() {
+		q.Set("earth_date", req.EarthDate.Format(time.DateOnly))
+	}
+	if len(req.Camera) > 0 {
+		q.Set("camera", strings.ToLower(req.Camera))
+	}
+	if req.Page > 0 {
+		q.Set("page", strconv.Itoa(req.Page))
+	}
+	return q, nil
+}
+
+func (req *Request) query() url.Values {
+	q := url.Values{}
+	q.Set("api_key", nasaapi.APIKey(req.APIKey))
+	return q
+}
+
+/* MIT License
+ *
+ * Copyright 2023 Spiegel
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
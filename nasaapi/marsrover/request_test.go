@@ -0,0 +1,114 @@
+package marsrover
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/goark/apod/ecode"
+	"github.com/goark/apod/internal/testutil"
+)
+
+func TestMakeQuery(t *testing.T) {
+	date := testutil.DateFromMust(t, "2015-05-30")
+	testCases := []struct {
+		req *Request
+		q   string
+		err error
+	}{
+		{req: New(WithSol(0)), q: "api_key=DEMO_KEY&sol=0", err: nil},
+		{req: New(WithSol(1000), WithCamera("FHAZ"), WithPage(2), WithAPIKey("foo")), q: "api_key=foo&camera=fhaz&page=2&sol=1000", err: nil},
+		{req: New(WithEarthDate(date)), q: "api_key=DEMO_KEY&earth_date=2015-05-30", err: nil},
+		{req: New(WithSol(1000), WithEarthDate(date)), err: ecode.ErrCombination},
+	}
+
+	for _, tc := range testCases {
+		q, err := tc.req.makeQuery()
+		if !errors.Is(err, tc.err) {
+			t.Errorf("makeQuery() is \"%v\", want \"%v\"", err, tc.err)
+		} else if err == nil && q.Encode() != tc.q {
+			t.Errorf("makeQuery() is \"%v\", want \"%v\"", q.Encode(), tc.q)
+		}
+	}
+}
+
+func TestAllPages(t *testing.T) {
+	var pageList []string
+	_, cli := testutil.NewServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != RoversPath+"/curiosity/photos" {
+			http.Error(w, `{"errors":"Invalid Rover Name"}`, http.StatusNotFound)
+			return
+		}
+		pageList = append(pageList, r.URL.Query().Get("page"))
+		page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+		n := PageSize
+		if page == 3 {
+			n = 3
+		}
+		photos := make([]string, 0, n)
+		for i := 0; i < n; i++ {
+			photos = append(photos, fmt.Sprintf(`{"id":%d,"sol":1000,"camera":{"name":"FHAZ"},"earth_date":"2015-05-30"}`, page*100+i))
+		}
+		_, _ = w.Write([]byte(`{"photos":[` + strings.Join(photos, ",") + `]}`))
+	})
+
+	testCases := []struct {
+		req      *Request
+		maxPages int
+		pageList []string
+		count    int
+		err      error
+	}{
+		{req: New(WithSol(1000), WithClient(cli)), maxPages: 0, pageList: []string{"1", "2", "3"}, count: PageSize*2 + 3, err: nil},
+		{req: New(WithSol(1000), WithPage(2), WithClient(cli)), maxPages: 0, pageList: []string{"2", "3"}, count: PageSize + 3, err: nil},
+		{req: New(WithSol(1000), WithClient(cli)), maxPages: 1, pageList: []string{"1"}, count: PageSize, err: nil},
+		{req: New(WithSol(1000), WithRover(Spirit), WithClient(cli)), maxPages: 0, pageList: nil, count: 0, err: ecode.ErrNotFound},
+	}
+
+	for _, tc := range testCases {
+		pageList = nil
+		count := 0
+		pages := 0
+		err := tc.req.AllPages(context.Background(), func(page int, photos []*Photo) bool {
+			pages++
+			count += len(photos)
+			return tc.maxPages == 0 || pages < tc.maxPages
+		})
+		if !errors.Is(err, tc.err) {
+			t.Errorf("AllPages() is \"%v\", want \"%v\"", err, tc.err)
+		}
+		if strings.Join(pageList, ",") != strings.Join(tc.pageList, ",") {
+			t.Errorf("requested pages are %v, want %v", pageList, tc.pageList)
+		}
+		if count != tc.count {
+			t.Errorf("AllPages() got %v photos, want %v photos", count, tc.count)
+		}
+	}
+}
+
+/* MIT License
+ *
+ * Copyright 2023 Spiegel
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
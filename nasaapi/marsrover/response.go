@@ -0,0 +1,85 @@
+package marsrover
+
+import "github.com/goark/apod/nasaapi"
+
+// Photo is photo data taken by Mars rover.
+type Photo struct {
+	ID        int          `json:"id"`
+	Sol       int          `json:"sol"`
+	Camera    Camera       `json:"camera"`
+	ImgSrc    string       `json:"img_src"`
+	EarthDate nasaapi.Date `json:"earth_date"`
+	Rover     RoverInfo    `json:"rover"`
+}
+
+// Camera is camera of Mars rover.
+type Camera struct {
+	ID       int    `json:"id"`
+	Name     string `json:"name"`
+	RoverID  int    `json:"rover_id"`
+	FullName string `json:"full_name"`
+}
+
+// RoverInfo is information of Mars rover.
+type RoverInfo struct {
+	ID          int          `json:"id"`
+	Name        string       `json:"name"`
+	LandingDate nasaapi.Date `json:"landing_date"`
+	LaunchDate  nasaapi.Date `json:"launch_date"`
+	Status      string       `json:"status"`
+}
+
+// Manifest is mission manifest of Mars rover.
+type Manifest struct {
+	Name        string         `json:"name"`
+	LandingDate nasaapi.Date   `json:"landing_date"`
+	LaunchDate  nasaapi.Date   `json:"launch_date"`
+	Status      string         `json:"status"`
+	MaxSol      int            `json:"max_sol"`
+	MaxDate     nasaapi.Date   `json:"max_date"`
+	TotalPhotos int            `json:"total_photos"`
+	Photos      []*ManifestSol `json:"photos"`
+}
+
+// ManifestSol is summary of photos in a sol.
+type ManifestSol struct {
+	Sol         int          `json:"sol"`
+	EarthDate   nasaapi.Date `json:"earth_date"`
+	TotalPhotos int          `json:"total_photos"`
+	Cameras     []string     `json:"cameras"`
+}
+
+type photosResponse struct {
+	Photos []*Photo `json:"photos"`
+}
+
+type latestPhotosResponse struct {
+	LatestPhotos []*Photo `json:"latest_photos"`
+}
+
+type manifestResponse struct {
+	PhotoManifest *Manifest `json:"photo_manifest"`
+}
+
+/* MIT License
+ *
+ * Copyright 2023 Spiegel
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
@@ -0,0 +1,248 @@
+package neows
+
+import (
+	"context"
+	"io"
+	"net/url"
+	"path"
+	"strconv"
+	"time"
+
+	"github.com/goark/apod/ecode"
+	"github.com/goark/apod/nasaapi"
+	"github.com/goark/errs"
+)
+
+const (
+	FeedPath   = "/neo/rest/v1/feed"
+	LookupPath = "/neo/rest/v1/neo"
+	BrowsePath = "/neo/rest/v1/neo/browse"
+
+	MaxFeedDays = 7 // Maximum number of days in a feed request
+)
+
+// Request is for context of NeoWs (Near Earth Object Web Service) API.
+type Request struct {
+	StartDate nasaapi.Date `json:"start_date,omitempty"` // Starting date for asteroid search
+	EndDate   nasaapi.Date `json:"end_date,omitempty"`   // Ending date for asteroid search (default is 7 days after start_date)
+	Page      int          `json:"page,omitempty"`       // Page number of browse request (0 origin)
+	Size      int          `json:"size,omitempty"`       // Page size of browse request
+	APIKey    string       `json:"api_key"`              // api.nasa.gov key for expanded usage
+	client    *nasaapi.Client
+}
+
+type Opts func(*Request)
+
+// New returns new Request instance for NeoWs API.
+func New(opts ...Opts) *Request {
+	ctx := &Request{}
+	for _, opt := range opts {
+		opt(ctx)
+	}
+	return ctx
+}
+
+// WithStartDate returns function for setting Request.StartDate.
+func WithStartDate(startDate nasaapi.Date) Opts {
+	return func(ctx *Request) {
+		if ctx != nil {
+			ctx.StartDate = startDate
+		}
+	}
+}
+
+// WithEndDate returns function for setting Request.EndDate.
+func WithEndDate(endDate nasaapi.Date) Opts {
+	return func(ctx *Request) {
+		if ctx != nil {
+			ctx.EndDate = endDate
+		}
+	}
+}
+
+// WithPage returns function for setting Request.Page.
+func WithPage(page int) Opts {
+	return func(ctx *Request) {
+		if ctx != nil {
+			ctx.Page = page
+		}
+	}
+}
+
+// WithSize returns function for setting Request.Size.
+func WithSize(size int) Opts {
+	return func(ctx *Request) {
+		if ctx != nil {
+			ctx.Size = size
+		}
+	}
+}
+
+// WithAPIKey returns function for setting Request.APIKey.
+func WithAPIKey(apiKey string) Opts {
+	return func(ctx *Request) {
+		if ctx != nil {
+			ctx.APIKey = apiKey
+		}
+	}
+}
+
+// WithClient returns function for setting nasaapi.Client.
+func WithClient(client *nasaapi.Client) Opts {
+	return func(ctx *Request) {
+		if ctx != nil {
+			ctx.client = client
+		}
+	}
+}
+
+// Client method returns nasaapi.Client instance for requesting. If not set, returns nasaapi.DefaultClient().
+func (req *Request) Client() *nasaapi.Client {
+	if req == nil || req.client == nil {
+		return nasaapi.DefaultClient()
+	}
+	return req.client
+}
+
+// Feed method gets list of asteroids based on their closest approach date to Earth.
+func (req *Request) Feed(ctx context.Context) (*Feed, error) {
+	if req == nil {
+		return nil, errs.Wrap(ecode.ErrNullPointer)
+	}
+	q, err := req.feedQuery()
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	var feed Feed
+	if err := req.Client().RequestJSON(ctx, FeedPath, q, &feed); err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return &feed, nil
+}
+
+// FeedRawData method gets raw response data of Feed method.
+func (req *Request) FeedRawData(ctx context.Context) (io.ReadCloser, error) {
+	if req == nil {
+		return nil, errs.Wrap(ecode.ErrNullPointer)
+	}
+	q, err := req.feedQuery()
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return req.Client().Request(ctx, FeedPath, q)
+}
+
+// Lookup method gets a specific asteroid based on its NASA JPL small body (SPK-ID) ID.
+func (req *Request) Lookup(ctx context.Context, id string) (*NearEarthObject, error) {
+	if req == nil {
+		return nil, errs.Wrap(ecode.ErrNullPointer)
+	}
+	if !isSPKID(id) {
+		return nil, errs.Wrap(ecode.ErrInvalidParameter, errs.WithContext("id", id))
+	}
+	var neo NearEarthObject
+	if err := req.Client().RequestJSON(ctx, path.Join(LookupPath, id), req.query(), &neo); err != nil {
+		return nil, errs.Wrap(err, errs.WithContext("id", id))
+	}
+	return &neo, nil
+}
+
+// Browse method gets a page of the overall asteroid data-set.
+func (req *Request) Browse(ctx context.Context) (*BrowsePage, error) {
+	if req == nil {
+		return nil, errs.Wrap(ecode.ErrNullPointer)
+	}
+	q := req.query()
+	if req.Page > 0 {
+		q.Set("page", strconv.Itoa(req.Page))
+	}
+	if req.Size > 0 {
+		q.Set("size", strconv.Itoa(req.Size))
+	}
+	var page BrowsePage
+	if err := req.Client().RequestJSON(ctx, BrowsePath, q, &page); err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return &page, nil
+}
+
+// BrowseAll method gets all pages of the overall asteroid data-set from Request.Page, and calls fn function for each page.
+// If fn returns false, browsing is stopped.
+func (req *Request) BrowseAll(ctx context.Context, fn func(*BrowsePage) bool) error {
+	if req == nil {
+		return errs.Wrap(ecode.ErrNullPointer)
+	}
+	r := *req
+	for {
+		page, err := r.Browse(ctx)
+		if err != nil {
+			return errs.Wrap(err, errs.WithContext("page", r.Page))
+		}
+		if !fn(page) || !page.HasNext() {
+			return nil
+		}
+		r.Page = page.Page.Number + 1
+	}
+}
+
+func (req *Request) feedQuery() (url.Values, error) {
+	q := req.query()
+	if !req.StartDate.IsZero() {
+		q.Set("start_date", req.StartDate.Format(time.DateOnly))
+	}
+	if !req.EndDate.IsZero() {
+		if req.StartDate.IsZero() {
+			return nil, errs.Wrap(ecode.ErrCombination, errs.WithContext("config", req))
+		}
+		if req.EndDate.Before(req.StartDate.Time) {
+			return nil, errs.Wrap(ecode.ErrEndBeforeStart, errs.WithContext("config", req))
+		}
+		if req.EndDate.After(req.StartDate.AddDate(0, 0, MaxFeedDays)) {
+			return nil, errs.Wrap(ecode.ErrRangeTooLong, errs.WithContext("config", req))
+		}
+		q.Set("end_date", req.EndDate.Format(time.DateOnly))
+	}
+	return q, nil
+}
+
+// isSPKID reports whether id is SPK-ID (digits only).
+func isSPKID(id string) bool {
+	if len(id) == 0 {
+		return false
+	}
+	for _, c := range id {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+func (req *Request) query() url.Values {
+	q := url.Values{}
+	q.Set("api_key", nasaapi.APIKey(req.APIKey))
+	return q
+}
+
+/* MIT License
+ *
+ * Copyright 2023 Spiegel
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
@@ -0,0 +1,111 @@
+package neows
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/goark/apod/ecode"
+	"github.com/goark/apod/internal/testutil"
+)
+
+func TestFeedQuery(t *testing.T) {
+	testCases := []struct {
+		req *Request
+		q   string
+		err error
+	}{
+		{req: New(), q: "api_key=DEMO_KEY", err: nil},
+		{req: New(WithStartDate(testutil.DateFromMust(t, "2023-02-01")), WithAPIKey("foo")), q: "api_key=foo&start_date=2023-02-01", err: nil},
+		{req: New(WithStartDate(testutil.DateFromMust(t, "2023-02-01")), WithEndDate(testutil.DateFromMust(t, "2023-02-08"))), q: "api_key=DEMO_KEY&end_date=2023-02-08&start_date=2023-02-01", err: nil},
+		{req: New(WithStartDate(testutil.DateFromMust(t, "2023-02-01")), WithEndDate(testutil.DateFromMust(t, "2023-02-09"))), err: ecode.ErrRangeTooLong},
+		{req: New(WithStartDate(testutil.DateFromMust(t, "2023-02-08")), WithEndDate(testutil.DateFromMust(t, "2023-02-01"))), err: ecode.ErrEndBeforeStart},
+		{req: New(WithEndDate(testutil.DateFromMust(t, "2023-02-01"))), err: ecode.ErrCombination},
+	}
+
+	for _, tc := range testCases {
+		q, err := tc.req.feedQuery()
+		if !errors.Is(err, tc.err) {
+			t.Errorf("feedQuery() is \"%v\", want \"%v\"", err, tc.err)
+		} else if err == nil && q.Encode() != tc.q {
+			t.Errorf("feedQuery() is \"%v\", want \"%v\"", q.Encode(), tc.q)
+		}
+	}
+}
+
+func TestRequest(t *testing.T) {
+	_, cli := testutil.NewServer(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case FeedPath:
+			_, _ = w.Write([]byte(`{"element_count":2,"near_earth_objects":{"2023-02-02":[{"id":"2","name":"bar","close_approach_data":[{"close_approach_date":"2023-02-02"}]}],"2023-02-01":[{"id":"1","name":"foo","close_approach_data":[{"close_approach_date":"2023-02-01"}]}]}}`))
+		case LookupPath + "/3542519":
+			_, _ = w.Write([]byte(`{"id":"3542519","name":"(2010 PK9)"}`))
+		case BrowsePath:
+			switch r.URL.Query().Get("page") {
+			case "":
+				_, _ = w.Write([]byte(`{"page":{"size":1,"total_elements":2,"total_pages":2,"number":0},"near_earth_objects":[{"id":"1"}]}`))
+			default:
+				_, _ = w.Write([]byte(`{"page":{"size":1,"total_elements":2,"total_pages":2,"number":1},"near_earth_objects":[{"id":"2"}]}`))
+			}
+		default:
+			http.NotFound(w, r)
+		}
+	})
+	req := New(WithClient(cli))
+
+	feed, err := req.Feed(context.Background())
+	if err != nil {
+		t.Fatalf("Feed() is \"%v\", want nil", err)
+	}
+	approaches := feed.Approaches()
+	if len(approaches) != 2 || approaches[0].ID != "1" || approaches[1].ID != "2" {
+		t.Errorf("Approaches() is %v, want 2 approaches in date order", approaches)
+	}
+
+	neo, err := req.Lookup(context.Background(), "3542519")
+	if err != nil {
+		t.Errorf("Lookup() is \"%v\", want nil", err)
+	} else if neo.Name != "(2010 PK9)" {
+		t.Errorf("Lookup() name is \"%v\", want \"%v\"", neo.Name, "(2010 PK9)")
+	}
+	if _, err := req.Lookup(context.Background(), "../feed"); !errors.Is(err, ecode.ErrInvalidParameter) {
+		t.Errorf("Lookup() is \"%v\", want \"%v\"", err, ecode.ErrInvalidParameter)
+	}
+
+	var ids []string
+	if err := req.BrowseAll(context.Background(), func(p *BrowsePage) bool {
+		for _, neo := range p.NearEarthObjects {
+			ids = append(ids, neo.ID)
+		}
+		return true
+	}); err != nil {
+		t.Errorf("BrowseAll() is \"%v\", want nil", err)
+	}
+	if len(ids) != 2 || ids[0] != "1" || ids[1] != "2" {
+		t.Errorf("BrowseAll() ids are %v, want [1 2]", ids)
+	}
+}
+
+/* MIT License
+ *
+ * Copyright 2023 Spiegel
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
@@ -0,0 +1,192 @@
+package neows
+
+import (
+	"sort"
+
+	"github.com/goark/apod/nasaapi"
+)
+
+// Links is links for navigation in NeoWs API.
+type Links struct {
+	Next string `json:"next,omitempty"`
+	Prev string `json:"prev,omitempty"`
+	Self string `json:"self,omitempty"`
+}
+
+// Feed is response data from /neo/rest/v1/feed.
+type Feed struct {
+	Links            Links                         `json:"links"`
+	ElementCount     int                           `json:"element_count"`
+	NearEarthObjects map[string][]*NearEarthObject `json:"near_earth_objects"` // key is date (YYYY-MM-DD)
+}
+
+// BrowsePage is response data from /neo/rest/v1/neo/browse.
+type BrowsePage struct {
+	Links            Links              `json:"links"`
+	Page             Page               `json:"page"`
+	NearEarthObjects []*NearEarthObject `json:"near_earth_objects"`
+}
+
+// Page is pagination information.
+type Page struct {
+	Size          int `json:"size"`
+	TotalElements int `json:"total_elements"`
+	TotalPages    int `json:"total_pages"`
+	Number        int `json:"number"`
+}
+
+// HasNext method reports whether next page exists.
+func (p *BrowsePage) HasNext() bool {
+	return p != nil && p.Page.Number+1 < p.Page.TotalPages
+}
+
+// NearEarthObject is information of near earth object (asteroid).
+type NearEarthObject struct {
+	Links                          Links             `json:"links"`
+	ID                             string            `json:"id"`
+	NeoReferenceID                 string            `json:"neo_reference_id"`
+	Name                           string            `json:"name"`
+	Designation                    string            `json:"designation,omitempty"`
+	NasaJplURL                     string            `json:"nasa_jpl_url"`
+	AbsoluteMagnitudeH             float64           `json:"absolute_magnitude_h"`
+	EstimatedDiameter              EstimatedDiameter `json:"estimated_diameter"`
+	IsPotentiallyHazardousAsteroid bool              `json:"is_potentially_hazardous_asteroid"`
+	CloseApproachData              []*CloseApproach  `json:"close_approach_data"`
+	OrbitalData                    *OrbitalData      `json:"orbital_data,omitempty"`
+	IsSentryObject                 bool              `json:"is_sentry_object"`
+}
+
+// EstimatedDiameter is estimated diameter of near earth object in some units.
+type EstimatedDiameter struct {
+	Kilometers DiameterRange `json:"kilometers"`
+	Meters     DiameterRange `json:"meters"`
+	Miles      DiameterRange `json:"miles"`
+	Feet       DiameterRange `json:"feet"`
+}
+
+// DiameterRange is range of estimated diameter.
+type DiameterRange struct {
+	Min float64 `json:"estimated_diameter_min"`
+	Max float64 `json:"estimated_diameter_max"`
+}
+
+// CloseApproach is close approach data of near earth object.
+type CloseApproach struct {
+	CloseApproachDate      nasaapi.Date     `json:"close_approach_date"`
+	CloseApproachDateFull  string           `json:"close_approach_date_full,omitempty"`
+	EpochDateCloseApproach int64            `json:"epoch_date_close_approach"`
+	RelativeVelocity       RelativeVelocity `json:"relative_velocity"`
+	MissDistance           MissDistance     `json:"miss_distance"`
+	OrbitingBody           string           `json:"orbiting_body"`
+}
+
+// RelativeVelocity is relative velocity of near earth object. (numbers in string)
+type RelativeVelocity struct {
+	KilometersPerSecond string `json:"kilometers_per_second"`
+	KilometersPerHour   string `json:"kilometers_per_hour"`
+	MilesPerHour        string `json:"miles_per_hour"`
+}
+
+// MissDistance is miss distance of near earth object. (numbers in string)
+type MissDistance struct {
+	Astronomical string `json:"astronomical"`
+	Lunar        string `json:"lunar"`
+	Kilometers   string `json:"kilometers"`
+	Miles        string `json:"miles"`
+}
+
+// OrbitalData is orbital data of near earth object.
+type OrbitalData struct {
+	OrbitID                string      `json:"orbit_id"`
+	OrbitDeterminationDate string      `json:"orbit_determination_date"`
+	FirstObservationDate   string      `json:"first_observation_date"`
+	LastObservationDate    string      `json:"last_observation_date"`
+	DataArcInDays          int         `json:"data_arc_in_days"`
+	ObservationsUsed       int         `json:"observations_used"`
+	OrbitUncertainty       string      `json:"orbit_uncertainty"`
+	MinimumOrbitIntersect  string      `json:"minimum_orbit_intersection"`
+	Eccentricity           string      `json:"eccentricity"`
+	SemiMajorAxis          string      `json:"semi_major_axis"`
+	Inclination            string      `json:"inclination"`
+	OrbitalPeriod          string      `json:"orbital_period"`
+	PerihelionDistance     string      `json:"perihelion_distance"`
+	AphelionDistance       string      `json:"aphelion_distance"`
+	OrbitClass             *OrbitClass `json:"orbit_class,omitempty"`
+}
+
+// OrbitClass is class of orbit.
+type OrbitClass struct {
+	OrbitClassType        string `json:"orbit_class_type"`
+	OrbitClassDescription string `json:"orbit_class_description"`
+	OrbitClassRange       string `json:"orbit_class_range"`
+}
+
+// Approach is flattened close approach of near earth object.
+type Approach struct {
+	Date                           nasaapi.Date `json:"date"`
+	ID                             string       `json:"id"`
+	Name                           string       `json:"name"`
+	IsPotentiallyHazardousAsteroid bool         `json:"is_potentially_hazardous_asteroid"`
+	EstimatedDiameterMinMeters     float64      `json:"estimated_diameter_min_m"`
+	EstimatedDiameterMaxMeters     float64      `json:"estimated_diameter_max_m"`
+	MissDistanceKilometers         string       `json:"miss_distance_km"`
+	MissDistanceLunar              string       `json:"miss_distance_lunar"`
+	RelativeVelocityKmPerSecond    string       `json:"relative_velocity_km_s"`
+	OrbitingBody                   string       `json:"orbiting_body"`
+}
+
+// Approaches method returns flattened close approaches in date order.
+func (f *Feed) Approaches() []*Approach {
+	if f == nil {
+		return nil
+	}
+	var approaches []*Approach
+	for _, neos := range f.NearEarthObjects {
+		for _, neo := range neos {
+			for _, ca := range neo.CloseApproachData {
+				approaches = append(approaches, &Approach{
+					Date:                           ca.CloseApproachDate,
+					ID:                             neo.ID,
+					Name:                           neo.Name,
+					IsPotentiallyHazardousAsteroid: neo.IsPotentiallyHazardousAsteroid,
+					EstimatedDiameterMinMeters:     neo.EstimatedDiameter.Meters.Min,
+					EstimatedDiameterMaxMeters:     neo.EstimatedDiameter.Meters.Max,
+					MissDistanceKilometers:         ca.MissDistance.Kilometers,
+					MissDistanceLunar:              ca.MissDistance.Lunar,
+					RelativeVelocityKmPerSecond:    ca.RelativeVelocity.KilometersPerSecond,
+					OrbitingBody:                   ca.OrbitingBody,
+				})
+			}
+		}
+	}
+	sort.SliceStable(approaches, func(i, j int) bool {
+		if approaches[i].Date.Equal(approaches[j].Date.Time) {
+			return approaches[i].ID < approaches[j].ID
+		}
+		return approaches[i].Date.Before(approaches[j].Date.Time)
+	})
+	return approaches
+}
+
+/* MIT License
+ *
+ * Copyright 2023 Spiegel
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
@@ -0,0 +1,144 @@
+package nasaapi
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/goark/apod/ecode"
+	"github.com/goark/errs"
+)
+
+var (
+	relativePattern = regexp.MustCompile(`^([+-]\d+)([dw])$`)
+	digitsPattern   = regexp.MustCompile(`^\d+$`)
+	monthPattern    = regexp.MustCompile(`^(\d{4})-(\d{2})$`)
+	weekdays        = map[string]time.Weekday{
+		"sunday":    time.Sunday,
+		"monday":    time.Monday,
+		"tuesday":   time.Tuesday,
+		"wednesday": time.Wednesday,
+		"thursday":  time.Thursday,
+		"friday":    time.Friday,
+		"saturday":  time.Saturday,
+	}
+)
+
+// ParseDate returns Date instance from date expression.
+// In addition to the formats of DateFrom function, it accepts the following expressions
+// ("today" is evaluated in PublishingLocation):
+//
+//	today, yesterday
+//	-7d, -2w      (relative days or weeks from today)
+//	last-monday   (the last weekday before today)
+//	20230224      (YYYYMMDD)
+//	230224        (YYMMDD, APOD style; 95-99 is 1995-1999)
+func ParseDate(s string) (Date, error) {
+	return parseDate(s, Today())
+}
+
+// ParseDateRange returns start and end Date instances from date expression.
+// Month (YYYY-MM) and year (YYYY) shorthands expand to the first and the last days.
+// Other expressions of ParseDate function return the same start and end Date.
+func ParseDateRange(s string) (Date, Date, error) {
+	return parseDateRange(s, Today())
+}
+
+func parseDateRange(s string, today Date) (Date, Date, error) {
+	s = strings.TrimSpace(s)
+	if m := monthPattern.FindStringSubmatch(s); m != nil {
+		year, _ := strconv.Atoi(m[1])
+		month, _ := strconv.Atoi(m[2])
+		if month < 1 || month > 12 {
+			return Date{}, Date{}, errs.Wrap(ecode.ErrInvalidDate, errs.WithContext("time_string", s))
+		}
+		start := time.Date(year, time.Month(month), 1, 0, 0, 0, 0, time.UTC)
+		return NewDate(start), NewDate(start.AddDate(0, 1, -1)), nil
+	}
+	if len(s) == 4 && digitsPattern.MatchString(s) {
+		year, _ := strconv.Atoi(s)
+		return NewDate(time.Date(year, time.January, 1, 0, 0, 0, 0, time.UTC)), NewDate(time.Date(year, time.December, 31, 0, 0, 0, 0, time.UTC)), nil
+	}
+	dt, err := parseDate(s, today)
+	if err != nil {
+		return Date{}, Date{}, err
+	}
+	return dt, dt, nil
+}
+
+func parseDate(s string, today Date) (Date, error) {
+	expr := strings.ToLower(strings.TrimSpace(s))
+	switch expr {
+	case "today":
+		return today, nil
+	case "yesterday":
+		return NewDate(today.AddDate(0, 0, -1)), nil
+	}
+	if m := relativePattern.FindStringSubmatch(expr); m != nil {
+		n, err := strconv.Atoi(m[1])
+		if err != nil {
+			return Date{}, errs.Wrap(ecode.ErrInvalidDate, errs.WithCause(err), errs.WithContext("time_string", s))
+		}
+		if m[2] == "w" {
+			n *= 7
+		}
+		return NewDate(today.AddDate(0, 0, n)), nil
+	}
+	if name, ok := strings.CutPrefix(expr, "last-"); ok {
+		wd, ok := weekdays[name]
+		if !ok {
+			return Date{}, errs.Wrap(ecode.ErrInvalidDate, errs.WithContext("time_string", s))
+		}
+		diff := int(today.Weekday() - wd)
+		if diff <= 0 {
+			diff += 7
+		}
+		return NewDate(today.AddDate(0, 0, -diff)), nil
+	}
+	if digitsPattern.MatchString(expr) {
+		switch len(expr) {
+		case 8:
+			return parseLayout(s, expr, "20060102")
+		case 6:
+			// APOD style (e.g. ap230224.html)
+			prefix := "20"
+			if expr >= "95" {
+				prefix = "19"
+			}
+			return parseLayout(s, prefix+expr, "20060102")
+		}
+	}
+	return DateFrom(s)
+}
+
+func parseLayout(s, value, layout string) (Date, error) {
+	tm, err := time.Parse(layout, value)
+	if err != nil {
+		return Date{}, errs.Wrap(ecode.ErrInvalidDate, errs.WithCause(err), errs.WithContext("time_string", s))
+	}
+	return NewDate(tm), nil
+}
+
+/* MIT License
+ *
+ * Copyright 2023 Spiegel
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
@@ -0,0 +1,92 @@
+package nasaapi
+
+import (
+	"testing"
+)
+
+func TestParseDate(t *testing.T) {
+	today, _ := DateFrom("2023-02-22") // Wednesday
+	testCases := []struct {
+		s     string
+		want  string
+		isErr bool
+	}{
+		{s: "2023-02-24", want: "2023-02-24", isErr: false},
+		{s: "2023-02-24T01:02:03Z", want: "2023-02-24", isErr: false},
+		{s: "today", want: "2023-02-22", isErr: false},
+		{s: "Yesterday", want: "2023-02-21", isErr: false},
+		{s: "-7d", want: "2023-02-15", isErr: false},
+		{s: "-1w", want: "2023-02-15", isErr: false},
+		{s: "+1d", want: "2023-02-23", isErr: false},
+		{s: "last-monday", want: "2023-02-20", isErr: false},
+		{s: "last-wednesday", want: "2023-02-15", isErr: false},
+		{s: "last-thursday", want: "2023-02-16", isErr: false},
+		{s: "20230224", want: "2023-02-24", isErr: false},
+		{s: "230224", want: "2023-02-24", isErr: false},
+		{s: "950616", want: "1995-06-16", isErr: false},
+		{s: "", want: "", isErr: false},
+		{s: "last-foo", want: "", isErr: true},
+		{s: "20231345", want: "", isErr: true},
+		{s: "2023", want: "", isErr: true},
+		{s: "2023-02", want: "", isErr: true},
+	}
+
+	for _, tc := range testCases {
+		dt, err := parseDate(tc.s, today)
+		if (err != nil) != tc.isErr {
+			t.Errorf("Is \"%v\" error ? %v, want %v", tc.s, err != nil, tc.isErr)
+		}
+		if err == nil && dt.String() != tc.want {
+			t.Errorf("parseDate(\"%v\") is \"%v\", want \"%v\"", tc.s, dt, tc.want)
+		}
+	}
+}
+
+func TestParseDateRange(t *testing.T) {
+	today, _ := DateFrom("2023-02-22")
+	testCases := []struct {
+		s     string
+		start string
+		end   string
+		isErr bool
+	}{
+		{s: "2023-02", start: "2023-02-01", end: "2023-02-28", isErr: false},
+		{s: "2024-02", start: "2024-02-01", end: "2024-02-29", isErr: false},
+		{s: "1999", start: "1999-01-01", end: "1999-12-31", isErr: false},
+		{s: "yesterday", start: "2023-02-21", end: "2023-02-21", isErr: false},
+		{s: "2023-13", start: "", end: "", isErr: true},
+	}
+
+	for _, tc := range testCases {
+		start, end, err := parseDateRange(tc.s, today)
+		if (err != nil) != tc.isErr {
+			t.Errorf("Is \"%v\" error ? %v, want %v", tc.s, err != nil, tc.isErr)
+		}
+		if err == nil && (start.String() != tc.start || end.String() != tc.end) {
+			t.Errorf("parseDateRange(\"%v\") is \"%v..%v\", want \"%v..%v\"", tc.s, start, end, tc.start, tc.end)
+		}
+	}
+}
+
+/* MIT License
+ *
+ * Copyright 2023 Spiegel
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
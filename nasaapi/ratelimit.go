@@ -0,0 +1,60 @@
+package nasaapi
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+)
+
+const (
+	HeaderRateLimitLimit     = "X-RateLimit-Limit"     // header name of request limit per hour
+	HeaderRateLimitRemaining = "X-RateLimit-Remaining" // header name of remaining requests
+)
+
+// RateLimit is rate limit information of NASA API (X-RateLimit-* headers).
+type RateLimit struct {
+	Limit     int `json:"limit"`     // requests per hour for the API key
+	Remaining int `json:"remaining"` // remaining requests in the current window
+}
+
+// RateLimitFrom returns RateLimit instance from HTTP response header.
+// If the header does not contain rate limit information, ok is false.
+func RateLimitFrom(h http.Header) (rl RateLimit, ok bool) {
+	limit, err := strconv.Atoi(h.Get(HeaderRateLimitLimit))
+	if err != nil {
+		return RateLimit{}, false
+	}
+	remaining, err := strconv.Atoi(h.Get(HeaderRateLimitRemaining))
+	if err != nil {
+		return RateLimit{}, false
+	}
+	return RateLimit{Limit: limit, Remaining: remaining}, true
+}
+
+// String method is Stringer.
+func (rl RateLimit) String() string {
+	return fmt.Sprintf("%d/%d requests remaining", rl.Remaining, rl.Limit)
+}
+
+/* MIT License
+ *
+ * Copyright 2023 Spiegel
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
@@ -0,0 +1,76 @@
+package nasaapi
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+)
+
+func TestRateLimitFrom(t *testing.T) {
+	testCases := []struct {
+		limit     string
+		remaining string
+		want      RateLimit
+		ok        bool
+	}{
+		{limit: "30", remaining: "29", want: RateLimit{Limit: 30, Remaining: 29}, ok: true},
+		{limit: "1000", remaining: "0", want: RateLimit{Limit: 1000, Remaining: 0}, ok: true},
+		{limit: "", remaining: "29", want: RateLimit{}, ok: false},
+		{limit: "30", remaining: "foo", want: RateLimit{}, ok: false},
+	}
+
+	for _, tc := range testCases {
+		h := http.Header{}
+		if len(tc.limit) > 0 {
+			h.Set(HeaderRateLimitLimit, tc.limit)
+		}
+		h.Set(HeaderRateLimitRemaining, tc.remaining)
+		rl, ok := RateLimitFrom(h)
+		if ok != tc.ok || rl != tc.want {
+			t.Errorf("RateLimitFrom() is %v (%v), want %v (%v)", rl, ok, tc.want, tc.ok)
+		}
+	}
+}
+
+func TestClientRateLimit(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set(HeaderRateLimitLimit, "30")
+		w.Header().Set(HeaderRateLimitRemaining, "0")
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer ts.Close()
+	u, _ := url.Parse(ts.URL)
+	cli := NewClient(WithBaseURL(u))
+	if _, ok := cli.RateLimit(); ok {
+		t.Error("Client.RateLimit() is ok before request, want not ok")
+	}
+	_, _ = cli.Request(context.Background(), "/foo", url.Values{})
+	if rl, ok := cli.RateLimit(); !ok || rl != (RateLimit{Limit: 30, Remaining: 0}) {
+		t.Errorf("Client.RateLimit() is %v (%v), want {30 0} (true)", rl, ok)
+	}
+}
+
+/* MIT License
+ *
+ * Copyright 2023 Spiegel
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
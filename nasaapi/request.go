@@ -4,34 +4,25 @@ import (
 	"context"
 	"io"
 	"net/url"
-
-	"github.com/goark/errs"
-	"github.com/goark/fetch"
 )
 
 const DefaultAPIKey = "DEMO_KEY" // Default NASA API key (for demo)
 
-const (
-	scheme = "https"
-	host   = "api.nasa.gov"
-)
+// APIKey function returns apiKey, or DefaultAPIKey if apiKey is empty.
+func APIKey(apiKey string) string {
+	if len(apiKey) == 0 {
+		return DefaultAPIKey
+	}
+	return apiKey
+}
 
-// Request function requests to NASA API, and returns response data.
+// Request function requests to NASA API with default Client, and returns response data.
 func Request(ctx context.Context, path string, q url.Values) (io.ReadCloser, error) {
-	resp, err := fetch.New().Get(getURL(path, q), fetch.WithContext(ctx))
-	if err != nil {
-		return nil, errs.Wrap(err)
-	}
-	return resp.Body(), nil
+	return DefaultClient().Request(ctx, path, q)
 }
 
 func getURL(path string, q url.Values) *url.URL {
-	return &url.URL{
-		Scheme:   scheme,
-		Host:     host,
-		Path:     path,
-		RawQuery: q.Encode(),
-	}
+	return DefaultClient().URL(path, q)
 }
 
 /* MIT License
@@ -0,0 +1,193 @@
+package nasaapi
+
+import (
+	"context"
+	"errors"
+	"io"
+	"math/rand"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"syscall"
+	"time"
+
+	"github.com/goark/errs"
+)
+
+// RetryPolicy is policy for retrying GET requests on transient failures.
+type RetryPolicy struct {
+	MaxAttempts int           // maximum number of attempts (0 or 1 is no retry)
+	MinBackoff  time.Duration // backoff before the first retry (doubled for each retry)
+	MaxBackoff  time.Duration // upper limit of backoff; also the longest Retry-After to be waited for
+}
+
+// DefaultRetryPolicy returns RetryPolicy instance with default values.
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxAttempts: 3,
+		MinBackoff:  time.Second,
+		MaxBackoff:  30 * time.Second,
+	}
+}
+
+// RetryNotify is function type called before each retry.
+type RetryNotify func(attempt int, wait time.Duration, err error)
+
+// WithRetryPolicy returns function for setting RetryPolicy.
+func WithRetryPolicy(policy RetryPolicy) ClientOpts {
+	return func(c *Client) {
+		if c != nil {
+			c.retryPolicy = policy
+		}
+	}
+}
+
+// WithRetryNotify returns function for setting RetryNotify function.
+func WithRetryNotify(notify RetryNotify) ClientOpts {
+	return func(c *Client) {
+		if c != nil {
+			c.retryNotify = notify
+		}
+	}
+}
+
+// Retry method calls fn function, and retries it by RetryPolicy while fn returns transient error.
+func (c *Client) Retry(ctx context.Context, fn func() error) error {
+	if c == nil {
+		return fn()
+	}
+	attempt := 1
+	for ; ; attempt++ {
+		err := fn()
+		if err == nil {
+			return nil
+		}
+		if attempt >= c.retryPolicy.MaxAttempts || !IsTransient(err) || ctx.Err() != nil {
+			if attempt > 1 {
+				return errs.Wrap(err, errs.WithContext("attempts", attempt))
+			}
+			return err
+		}
+		wait := c.retryPolicy.backoff(attempt)
+		if ra, ok := retryAfter(err); ok {
+			if ra > c.retryPolicy.MaxBackoff {
+				return errs.Wrap(err, errs.WithContext("attempts", attempt), errs.WithContext("retry_after", ra.String()))
+			}
+			if ra > wait {
+				wait = ra
+			}
+		}
+		if c.retryNotify != nil {
+			c.retryNotify(attempt, wait, err)
+		}
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return errs.Wrap(ctx.Err(), errs.WithCause(err), errs.WithContext("attempts", attempt))
+		case <-timer.C:
+		}
+	}
+}
+
+// backoff method returns exponential backoff duration with jitter for the attempt.
+func (p RetryPolicy) backoff(attempt int) time.Duration {
+	if p.MinBackoff <= 0 {
+		return 0
+	}
+	d := p.MinBackoff
+	for i := 1; i < attempt && (p.MaxBackoff <= 0 || d < p.MaxBackoff); i++ {
+		d *= 2
+	}
+	if p.MaxBackoff > 0 && d > p.MaxBackoff {
+		d = p.MaxBackoff
+	}
+	// equal jitter: [d/2, d)
+	half := d / 2
+	return half + time.Duration(rand.Int63n(int64(d-half)+1)) //nolint:gosec // jitter does not need cryptographic randomness
+}
+
+// IsTransient function reports whether err is transient failure (retryable).
+func IsTransient(err error) bool {
+	if err == nil {
+		return false
+	}
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		switch apiErr.StatusCode {
+		case http.StatusRequestTimeout, http.StatusTooManyRequests, http.StatusInternalServerError, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+			return true
+		}
+		return false
+	}
+	if errors.Is(err, context.Canceled) {
+		return false
+	}
+	// *url.Error implements net.Error for any failure of http.Client (e.g. unsupported scheme or invalid certificate),
+	// so only the underlying error is checked.
+	var urlErr *url.Error
+	if errors.As(err, &urlErr) {
+		err = urlErr.Err
+	}
+	var dnsErr *net.DNSError
+	if errors.As(err, &dnsErr) {
+		return dnsErr.IsTimeout || dnsErr.IsTemporary
+	}
+	var netErr net.Error
+	if errors.As(err, &netErr) && netErr.Timeout() {
+		return true
+	}
+	return errors.Is(err, syscall.ECONNRESET) || errors.Is(err, syscall.ECONNREFUSED) || errors.Is(err, io.ErrUnexpectedEOF)
+}
+
+// retryAfter function returns duration of Retry-After header in APIError.
+func retryAfter(err error) (time.Duration, bool) {
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) || apiErr.RetryAfter <= 0 {
+		return 0, false
+	}
+	return apiErr.RetryAfter, true
+}
+
+// parseRetryAfter function parses value of Retry-After header (delay-seconds or HTTP-date).
+func parseRetryAfter(s string) time.Duration {
+	if len(s) == 0 {
+		return 0
+	}
+	if sec, err := strconv.Atoi(s); err == nil {
+		if sec < 0 {
+			return 0
+		}
+		return time.Duration(sec) * time.Second
+	}
+	if tm, err := http.ParseTime(s); err == nil {
+		if d := time.Until(tm); d > 0 {
+			return d
+		}
+	}
+	return 0
+}
+
+/* MIT License
+ *
+ * Copyright 2023 Spiegel
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
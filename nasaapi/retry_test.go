@@ -0,0 +1,161 @@
+package nasaapi
+
+import (
+	"context"
+	"crypto/x509"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"sync/atomic"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/goark/apod/ecode"
+	"github.com/goark/errs"
+)
+
+func TestRetry(t *testing.T) {
+	testCases := []struct {
+		failures   int32
+		status     int
+		retryAfter string
+		attempts   int32
+		err        error
+	}{
+		{failures: 2, status: http.StatusServiceUnavailable, retryAfter: "", attempts: 3, err: nil},
+		{failures: 3, status: http.StatusBadGateway, retryAfter: "", attempts: 3, err: ecode.ErrServerError},
+		{failures: 1, status: http.StatusBadRequest, retryAfter: "", attempts: 1, err: ecode.ErrHTTPStatus},
+		{failures: 1, status: http.StatusTooManyRequests, retryAfter: "0", attempts: 2, err: nil},
+		{failures: 1, status: http.StatusTooManyRequests, retryAfter: "3600", attempts: 1, err: ecode.ErrRateLimited},
+	}
+
+	for _, tc := range testCases {
+		var count int32
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if atomic.AddInt32(&count, 1) <= tc.failures {
+				if len(tc.retryAfter) > 0 {
+					w.Header().Set("Retry-After", tc.retryAfter)
+				}
+				w.WriteHeader(tc.status)
+				return
+			}
+			_, _ = w.Write([]byte(`{}`))
+		}))
+		u, _ := url.Parse(ts.URL)
+		notified := 0
+		cli := NewClient(
+			WithBaseURL(u),
+			WithRetryPolicy(RetryPolicy{MaxAttempts: 3, MinBackoff: time.Millisecond, MaxBackoff: 10 * time.Millisecond}),
+			WithRetryNotify(func(attempt int, wait time.Duration, err error) { notified++ }),
+		)
+		r, err := cli.Request(context.Background(), "/foo", url.Values{})
+		if err == nil {
+			r.Close()
+		}
+		ts.Close()
+		if !errors.Is(err, tc.err) || (tc.err == nil && err != nil) {
+			t.Errorf("Request() is \"%v\", want \"%v\"", err, tc.err)
+		}
+		if count != tc.attempts {
+			t.Errorf("number of attempts is %v, want %v", count, tc.attempts)
+		}
+		if notified != int(tc.attempts)-1 {
+			t.Errorf("number of notifications is %v, want %v", notified, tc.attempts-1)
+		}
+	}
+}
+
+func TestIsTransient(t *testing.T) {
+	urlErr := func(err error) error {
+		return errs.Wrap(&url.Error{Op: "Get", URL: "https://api.nasa.gov/planetary/apod", Err: err})
+	}
+	testCases := []struct {
+		name      string
+		err       error
+		transient bool
+	}{
+		{name: "nil", err: nil, transient: false},
+		{name: "503", err: errs.Wrap(&APIError{StatusCode: http.StatusServiceUnavailable}), transient: true},
+		{name: "404", err: errs.Wrap(&APIError{StatusCode: http.StatusNotFound}), transient: false},
+		{name: "canceled", err: urlErr(context.Canceled), transient: false},
+		{name: "timeout", err: urlErr(&net.OpError{Op: "read", Net: "tcp", Err: os.ErrDeadlineExceeded}), transient: true},
+		{name: "connection reset", err: urlErr(&net.OpError{Op: "read", Net: "tcp", Err: os.NewSyscallError("read", syscall.ECONNRESET)}), transient: true},
+		{name: "connection refused", err: urlErr(&net.OpError{Op: "dial", Net: "tcp", Err: os.NewSyscallError("connect", syscall.ECONNREFUSED)}), transient: true},
+		{name: "unexpected EOF", err: urlErr(io.ErrUnexpectedEOF), transient: true},
+		{name: "temporary DNS error", err: urlErr(&net.OpError{Op: "dial", Net: "tcp", Err: &net.DNSError{Err: "server misbehaving", IsTemporary: true}}), transient: true},
+		{name: "unknown host", err: urlErr(&net.OpError{Op: "dial", Net: "tcp", Err: &net.DNSError{Err: "no such host", IsNotFound: true}}), transient: false},
+		{name: "unsupported scheme", err: urlErr(errors.New(`unsupported protocol scheme "ftp"`)), transient: false},
+		{name: "unknown authority", err: urlErr(x509.UnknownAuthorityError{}), transient: false},
+		{name: "invalid hostname", err: urlErr(x509.HostnameError{Host: "example.com"}), transient: false},
+		{name: "invalid URL", err: errs.Wrap(&url.Error{Op: "parse", URL: "http://[::1", Err: errors.New("missing ']' in host")}), transient: false},
+	}
+	for _, tc := range testCases {
+		if transient := IsTransient(tc.err); transient != tc.transient {
+			t.Errorf("IsTransient(%v) is \"%v\", want \"%v\"", tc.name, transient, tc.transient)
+		}
+	}
+}
+
+func TestRetryPermanentTransportError(t *testing.T) {
+	u, _ := url.Parse("ftp://example.com")
+	notified := 0
+	cli := NewClient(
+		WithBaseURL(u),
+		WithKeyLimiter(nil),
+		WithRetryPolicy(RetryPolicy{MaxAttempts: 3, MinBackoff: time.Millisecond, MaxBackoff: 10 * time.Millisecond}),
+		WithRetryNotify(func(attempt int, wait time.Duration, err error) { notified++ }),
+	)
+	if _, err := cli.Request(context.Background(), "/foo", url.Values{}); err == nil {
+		t.Error("Request() is nil, want error")
+	}
+	if notified != 0 {
+		t.Errorf("number of notifications is %v, want 0", notified)
+	}
+}
+
+func TestBackoff(t *testing.T) {
+	p := RetryPolicy{MaxAttempts: 5, MinBackoff: 100 * time.Millisecond, MaxBackoff: 300 * time.Millisecond}
+	testCases := []struct {
+		attempt int
+		min     time.Duration
+		max     time.Duration
+	}{
+		{attempt: 1, min: 50 * time.Millisecond, max: 100 * time.Millisecond},
+		{attempt: 2, min: 100 * time.Millisecond, max: 200 * time.Millisecond},
+		{attempt: 3, min: 150 * time.Millisecond, max: 300 * time.Millisecond},
+		{attempt: 10, min: 150 * time.Millisecond, max: 300 * time.Millisecond},
+	}
+	for _, tc := range testCases {
+		if d := p.backoff(tc.attempt); d < tc.min || d > tc.max {
+			t.Errorf("backoff(%v) is %v, want [%v, %v]", tc.attempt, d, tc.min, tc.max)
+		}
+	}
+}
+
+/* MIT License
+ *
+ * Copyright 2023 Spiegel
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
@@ -0,0 +1,255 @@
+package cad
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+
+	"github.com/goark/apod/ecode"
+	"github.com/goark/apod/nasaapi"
+	"github.com/goark/apod/nasaapi/ssd"
+	"github.com/goark/errs"
+)
+
+// Path is path of SBDB Close Approach Data API.
+const Path = "/cad.api"
+
+// Approach is close approach data of small body.
+type Approach struct {
+	Des           string    `json:"des"`            // primary designation of the object
+	OrbitID       string    `json:"orbit_id"`       // orbit ID used for the close approach computation
+	JD            ssd.Float `json:"jd"`             // time of close approach (JD Ephemeris Time, TDB)
+	CD            string    `json:"cd"`             // time of close approach (formatted calendar date/time, TDB)
+	Dist          ssd.Float `json:"dist"`           // nominal approach distance (au)
+	DistMin       ssd.Float `json:"dist_min"`       // minimum (3-sigma) approach distance (au)
+	DistMax       ssd.Float `json:"dist_max"`       // maximum (3-sigma) approach distance (au)
+	VRel          ssd.Float `json:"v_rel"`          // velocity relative to the approach body at close approach (km/s)
+	VInf          ssd.Float `json:"v_inf"`          // velocity relative to a massless body (km/s)
+	TSigmaF       string    `json:"t_sigma_f"`      // 3-sigma uncertainty in the time of close approach (formatted)
+	H             ssd.Float `json:"h"`              // absolute magnitude H (mag)
+	Diameter      ssd.Float `json:"diameter"`       // diameter of the body (km)
+	DiameterSigma ssd.Float `json:"diameter_sigma"` // 1-sigma uncertainty in diameter of the body (km)
+	Fullname      string    `json:"fullname"`       // formatted full-name/designation of the object
+	Body          string    `json:"body"`           // name of the close-approach body (only with body=ALL)
+}
+
+// Request is for context of SBDB Close Approach Data API.
+type Request struct {
+	DateMin  string `json:"date-min,omitempty"` // exclude data earlier than this date (YYYY-MM-DD, "now" or "+N" days; default is now)
+	DateMax  string `json:"date-max,omitempty"` // exclude data later than this date (default is +60)
+	DistMin  string `json:"dist-min,omitempty"` // exclude data with an approach distance less than this (au or "NLD")
+	DistMax  string `json:"dist-max,omitempty"` // exclude data with an approach distance greater than this (default is 0.05)
+	HMin     string `json:"h-min,omitempty"`    // exclude data from objects with H-values less than this
+	HMax     string `json:"h-max,omitempty"`    // exclude data from objects with H-values greater than this
+	Body     string `json:"body,omitempty"`     // close-approach body (default is Earth, ALL is all bodies)
+	Des      string `json:"des,omitempty"`      // only data for the object matching this designation
+	PHA      bool   `json:"pha,omitempty"`      // only data for PHAs
+	NEA      bool   `json:"nea,omitempty"`      // only data for NEAs
+	Sort     string `json:"sort,omitempty"`     // sort data on the field (e.g. date, dist, -dist)
+	Limit    int    `json:"limit,omitempty"`    // limit data to the first N results
+	Fullname bool   `json:"fullname,omitempty"` // include full-name/designation
+	Diameter bool   `json:"diameter,omitempty"` // include known diameter
+	ssd.Config
+}
+
+type Opts func(*Request)
+
+// New returns new Request instance for SBDB Close Approach Data API.
+func New(opts ...Opts) *Request {
+	ctx := &Request{}
+	for _, opt := range opts {
+		opt(ctx)
+	}
+	ctx.ResetClient()
+	return ctx
+}
+
+// WithDateRange returns function for setting Request.DateMin and Request.DateMax.
+func WithDateRange(minValue, maxValue string) Opts {
+	return func(ctx *Request) {
+		if ctx != nil {
+			ctx.DateMin, ctx.DateMax = minValue, maxValue
+		}
+	}
+}
+
+// WithDistRange returns function for setting Request.DistMin and Request.DistMax.
+func WithDistRange(minValue, maxValue string) Opts {
+	return func(ctx *Request) {
+		if ctx != nil {
+			ctx.DistMin, ctx.DistMax = minValue, maxValue
+		}
+	}
+}
+
+// WithHRange returns function for setting Request.HMin and Request.HMax.
+func WithHRange(minValue, maxValue string) Opts {
+	return func(ctx *Request) {
+		if ctx != nil {
+			ctx.HMin, ctx.HMax = minValue, maxValue
+		}
+	}
+}
+
+// WithBody returns function for setting Request.Body.
+func WithBody(body string) Opts {
+	return func(ctx *Request) {
+		if ctx != nil {
+			ctx.Body = body
+		}
+	}
+}
+
+// WithDes returns function for setting Request.Des.
+func WithDes(des string) Opts {
+	return func(ctx *Request) {
+		if ctx != nil {
+			ctx.Des = des
+		}
+	}
+}
+
+// WithPHA returns function for setting Request.PHA.
+func WithPHA(flag bool) Opts {
+	return func(ctx *Request) {
+		if ctx != nil {
+			ctx.PHA = flag
+		}
+	}
+}
+
+// WithNEA returns function for setting Request.NEA.
+func WithNEA(flag bool) Opts {
+	return func(ctx *Request) {
+		if ctx != nil {
+			ctx.NEA = flag
+		}
+	}
+}
+
+// WithSort returns function for setting Request.Sort.
+func WithSort(sort string) Opts {
+	return func(ctx *Request) {
+		if ctx != nil {
+			ctx.Sort = sort
+		}
+	}
+}
+
+// WithLimit returns function for setting Request.Limit.
+func WithLimit(limit int) Opts {
+	return func(ctx *Request) {
+		if ctx != nil {
+			ctx.Limit = limit
+		}
+	}
+}
+
+// WithFullname returns function for setting Request.Fullname.
+func WithFullname(flag bool) Opts {
+	return func(ctx *Request) {
+		if ctx != nil {
+			ctx.Fullname = flag
+		}
+	}
+}
+
+// WithDiameter returns function for setting Request.Diameter.
+func WithDiameter(flag bool) Opts {
+	return func(ctx *Request) {
+		if ctx != nil {
+			ctx.Diameter = flag
+		}
+	}
+}
+
+// WithBaseURL returns function for setting base URL of JPL SSD/CNEOS API.
+func WithBaseURL(u *url.URL) Opts {
+	return func(ctx *Request) {
+		if ctx != nil {
+			ctx.SetBaseURL(u)
+		}
+	}
+}
+
+// WithClient returns function for setting nasaapi.Client.
+func WithClient(cli *nasaapi.Client) Opts {
+	return func(ctx *Request) {
+		if ctx != nil {
+			ctx.SetClient(cli)
+		}
+	}
+}
+
+// Get method gets close approach data.
+func (req *Request) Get(ctx context.Context) ([]*Approach, error) {
+	if req == nil {
+		return nil, errs.Wrap(ecode.ErrNullPointer)
+	}
+	t, err := req.GetTable(ctx, Path, req.makeQuery())
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	var list []*Approach
+	if err := t.Decode(&list); err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return list, nil
+}
+
+func (req *Request) makeQuery() url.Values {
+	q := url.Values{}
+	set := func(name, value string) {
+		if len(value) > 0 {
+			q.Set(name, value)
+		}
+	}
+	set("date-min", req.DateMin)
+	set("date-max", req.DateMax)
+	set("dist-min", req.DistMin)
+	set("dist-max", req.DistMax)
+	set("h-min", req.HMin)
+	set("h-max", req.HMax)
+	set("body", req.Body)
+	set("des", req.Des)
+	set("sort", req.Sort)
+	if req.PHA {
+		q.Set("pha", "true")
+	}
+	if req.NEA {
+		q.Set("nea", "true")
+	}
+	if req.Limit > 0 {
+		q.Set("limit", strconv.Itoa(req.Limit))
+	}
+	if req.Fullname {
+		q.Set("fullname", "true")
+	}
+	if req.Diameter {
+		q.Set("diameter", "true")
+	}
+	return q
+}
+
+/* MIT License
+ *
+ * Copyright 2023 Spiegel
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
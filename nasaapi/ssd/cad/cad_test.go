@@ -0,0 +1,70 @@
+package cad
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/goark/apod/internal/testutil"
+	"github.com/goark/apod/nasaapi"
+)
+
+func TestGet(t *testing.T) {
+	var query string
+	u, _ := testutil.NewServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != Path {
+			http.NotFound(w, r)
+			return
+		}
+		query = r.URL.RawQuery
+		if r.URL.Query().Get("date-min") == "now" {
+			http.Error(w, `{"message":"invalid value specified for query parameter 'date-min'","code":"400"}`, http.StatusBadRequest)
+			return
+		}
+		_, _ = w.Write([]byte(`{"signature":{"source":"NASA/JPL SBDB Close Approach Data API","version":"1.5"},"count":"1","fields":["des","orbit_id","jd","cd","dist","dist_min","dist_max","v_rel","v_inf","t_sigma_f","h"],"data":[["2023 DZ2","9","2460029.321","2023-Mar-25 19:43","0.00116","0.00116","0.00116","7.76","7.73","< 00:01","24.3"]]}`))
+	})
+	req := New(WithDateRange("2023-03-01", "2023-03-31"), WithDistRange("", "10LD"), WithPHA(true), WithLimit(10), WithBaseURL(u), WithClient(nasaapi.NewClient(nasaapi.WithKeyLimiter(nil))))
+
+	list, err := req.Get(context.Background())
+	if err != nil {
+		t.Fatalf("Get() is \"%v\", want nil", err)
+	}
+	if want := "date-max=2023-03-31&date-min=2023-03-01&dist-max=10LD&limit=10&pha=true"; query != want {
+		t.Errorf("query is \"%v\", want \"%v\"", query, want)
+	}
+	if len(list) != 1 {
+		t.Fatalf("Get() is %v, want 1 approach", list)
+	}
+	if a := list[0]; a.Des != "2023 DZ2" || a.Dist.Value != 0.00116 || a.H.String() != "24.3" || a.Diameter.Valid {
+		t.Errorf("Get() is %+v", a)
+	}
+
+	var apiErr *nasaapi.APIError
+	if _, err := New(WithDateRange("now", ""), WithBaseURL(u), WithClient(nasaapi.NewClient(nasaapi.WithKeyLimiter(nil)))).Get(context.Background()); !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusBadRequest {
+		t.Errorf("Get() is \"%v\", want HTTP 400 error", err)
+	}
+}
+
+/* MIT License
+ *
+ * Copyright 2023 Spiegel
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
@@ -0,0 +1,101 @@
+package ssd
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/goark/apod/nasaapi"
+	"github.com/goark/errs"
+)
+
+// DefaultBaseURL is default base URL of JPL SSD/CNEOS API.
+const DefaultBaseURL = "https://ssd-api.jpl.nasa.gov"
+
+// Config is common configuration of JPL SSD/CNEOS API requests.
+type Config struct {
+	baseURL *url.URL
+	client  *nasaapi.Client
+	cli     *nasaapi.Client // client for base URL (made by ResetClient method)
+}
+
+// SetBaseURL method sets base URL of JPL SSD/CNEOS API.
+func (c *Config) SetBaseURL(u *url.URL) {
+	if c != nil {
+		c.baseURL = u
+	}
+}
+
+// SetClient method sets nasaapi.Client. Base URL of the client is replaced by DefaultBaseURL (or SetBaseURL method).
+func (c *Config) SetClient(cli *nasaapi.Client) {
+	if c != nil {
+		c.client = cli
+	}
+}
+
+// BaseURL method returns base URL of JPL SSD/CNEOS API.
+func (c *Config) BaseURL() *url.URL {
+	if c == nil || c.baseURL == nil {
+		u, _ := url.Parse(DefaultBaseURL)
+		return u
+	}
+	u := *c.baseURL
+	return &u
+}
+
+// ResetClient method makes nasaapi.Client instance for current base URL and client. It is called by New function of each API.
+func (c *Config) ResetClient() {
+	if c != nil {
+		c.cli = c.newClient()
+	}
+}
+
+// Client method returns nasaapi.Client instance for requesting JPL SSD/CNEOS API.
+// It returns the same instance for each call, so rate limit information of the last request is available.
+func (c *Config) Client() *nasaapi.Client {
+	if c != nil && c.cli != nil {
+		return c.cli
+	}
+	return c.newClient()
+}
+
+func (c *Config) newClient() *nasaapi.Client {
+	var cli *nasaapi.Client
+	if c != nil && c.client != nil {
+		cli = c.client
+	} else {
+		cli = nasaapi.DefaultClient()
+	}
+	return cli.Clone(nasaapi.WithBaseURL(c.BaseURL()))
+}
+
+// GetTable method requests to JPL SSD/CNEOS API, and returns response data in table format.
+func (c *Config) GetTable(ctx context.Context, path string, q url.Values) (*Table, error) {
+	var t Table
+	if err := c.Client().RequestJSON(ctx, path, q, &t); err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return &t, nil
+}
+
+/* MIT License
+ *
+ * Copyright 2023 Spiegel
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
@@ -0,0 +1,209 @@
+package fireball
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+
+	"github.com/goark/apod/ecode"
+	"github.com/goark/apod/nasaapi"
+	"github.com/goark/apod/nasaapi/ssd"
+	"github.com/goark/errs"
+)
+
+// Path is path of Fireball API.
+const Path = "/fireball.api"
+
+// Fireball is fireball (bolide) event data.
+type Fireball struct {
+	Date    string    `json:"date"`     // date/time of peak brightness (UT)
+	Energy  ssd.Float `json:"energy"`   // approximate total radiated energy (10^10 joules)
+	ImpactE ssd.Float `json:"impact-e"` // approximate total impact energy (kt)
+	Lat     ssd.Float `json:"lat"`      // latitude at peak brightness (degrees)
+	LatDir  string    `json:"lat-dir"`  // latitude direction ("N" or "S")
+	Lon     ssd.Float `json:"lon"`      // longitude at peak brightness (degrees)
+	LonDir  string    `json:"lon-dir"`  // longitude direction ("E" or "W")
+	Alt     ssd.Float `json:"alt"`      // altitude above the geoid at peak brightness (km)
+	Vel     ssd.Float `json:"vel"`      // velocity at peak brightness (km/s)
+	Vx      ssd.Float `json:"vx"`       // pre-entry velocity (Earth centered X component, km/s)
+	Vy      ssd.Float `json:"vy"`       // pre-entry velocity (Earth centered Y component, km/s)
+	Vz      ssd.Float `json:"vz"`       // pre-entry velocity (Earth centered Z component, km/s)
+}
+
+// Request is for context of Fireball API.
+type Request struct {
+	DateMin    string `json:"date-min,omitempty"`     // exclude data earlier than this date (YYYY-MM-DD or YYYY-MM-DDThh:mm:ss)
+	DateMax    string `json:"date-max,omitempty"`     // exclude data later than this date
+	EnergyMin  string `json:"energy-min,omitempty"`   // exclude data with total radiated energy less than this
+	EnergyMax  string `json:"energy-max,omitempty"`   // exclude data with total radiated energy greater than this
+	ImpactEMin string `json:"impact-e-min,omitempty"` // exclude data with estimated impact energy less than this
+	ImpactEMax string `json:"impact-e-max,omitempty"` // exclude data with estimated impact energy greater than this
+	ReqLoc     bool   `json:"req-loc,omitempty"`      // only data with location
+	ReqAlt     bool   `json:"req-alt,omitempty"`      // only data with altitude
+	ReqVel     bool   `json:"req-vel,omitempty"`      // only data with velocity
+	VelComp    bool   `json:"vel-comp,omitempty"`     // include velocity components
+	Sort       string `json:"sort,omitempty"`         // sort data on the field (e.g. date, -energy)
+	Limit      int    `json:"limit,omitempty"`        // limit data to the first N results
+	ssd.Config
+}
+
+type Opts func(*Request)
+
+// New returns new Request instance for Fireball API.
+func New(opts ...Opts) *Request {
+	ctx := &Request{}
+	for _, opt := range opts {
+		opt(ctx)
+	}
+	ctx.ResetClient()
+	return ctx
+}
+
+// WithDateRange returns function for setting Request.DateMin and Request.DateMax.
+func WithDateRange(minValue, maxValue string) Opts {
+	return func(ctx *Request) {
+		if ctx != nil {
+			ctx.DateMin, ctx.DateMax = minValue, maxValue
+		}
+	}
+}
+
+// WithEnergyRange returns function for setting Request.EnergyMin and Request.EnergyMax.
+func WithEnergyRange(minValue, maxValue string) Opts {
+	return func(ctx *Request) {
+		if ctx != nil {
+			ctx.EnergyMin, ctx.EnergyMax = minValue, maxValue
+		}
+	}
+}
+
+// WithImpactERange returns function for setting Request.ImpactEMin and Request.ImpactEMax.
+func WithImpactERange(minValue, maxValue string) Opts {
+	return func(ctx *Request) {
+		if ctx != nil {
+			ctx.ImpactEMin, ctx.ImpactEMax = minValue, maxValue
+		}
+	}
+}
+
+// WithRequired returns function for setting Request.ReqLoc, Request.ReqAlt and Request.ReqVel.
+func WithRequired(loc, alt, vel bool) Opts {
+	return func(ctx *Request) {
+		if ctx != nil {
+			ctx.ReqLoc, ctx.ReqAlt, ctx.ReqVel = loc, alt, vel
+		}
+	}
+}
+
+// WithVelComp returns function for setting Request.VelComp.
+func WithVelComp(flag bool) Opts {
+	return func(ctx *Request) {
+		if ctx != nil {
+			ctx.VelComp = flag
+		}
+	}
+}
+
+// WithSort returns function for setting Request.Sort.
+func WithSort(sort string) Opts {
+	return func(ctx *Request) {
+		if ctx != nil {
+			ctx.Sort = sort
+		}
+	}
+}
+
+// WithLimit returns function for setting Request.Limit.
+func WithLimit(limit int) Opts {
+	return func(ctx *Request) {
+		if ctx != nil {
+			ctx.Limit = limit
+		}
+	}
+}
+
+// WithBaseURL returns function for setting base URL of JPL SSD/CNEOS API.
+func WithBaseURL(u *url.URL) Opts {
+	return func(ctx *Request) {
+		if ctx != nil {
+			ctx.SetBaseURL(u)
+		}
+	}
+}
+
+// WithClient returns function for setting nasaapi.Client.
+func WithClient(cli *nasaapi.Client) Opts {
+	return func(ctx *Request) {
+		if ctx != nil {
+			ctx.SetClient(cli)
+		}
+	}
+}
+
+// Get method gets fireball data.
+func (req *Request) Get(ctx context.Context) ([]*Fireball, error) {
+	if req == nil {
+		return nil, errs.Wrap(ecode.ErrNullPointer)
+	}
+	t, err := req.GetTable(ctx, Path, req.makeQuery())
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	var list []*Fireball
+	if err := t.Decode(&list); err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return list, nil
+}
+
+func (req *Request) makeQuery() url.Values {
+	q := url.Values{}
+	set := func(name, value string) {
+		if len(value) > 0 {
+			q.Set(name, value)
+		}
+	}
+	setBool := func(name string, flag bool) {
+		if flag {
+			q.Set(name, "true")
+		}
+	}
+	set("date-min", req.DateMin)
+	set("date-max", req.DateMax)
+	set("energy-min", req.EnergyMin)
+	set("energy-max", req.EnergyMax)
+	set("impact-e-min", req.ImpactEMin)
+	set("impact-e-max", req.ImpactEMax)
+	setBool("req-loc", req.ReqLoc)
+	setBool("req-alt", req.ReqAlt)
+	setBool("req-vel", req.ReqVel)
+	setBool("vel-comp", req.VelComp)
+	set("sort", req.Sort)
+	if req.Limit > 0 {
+		q.Set("limit", strconv.Itoa(req.Limit))
+	}
+	return q
+}
+
+/* MIT License
+ *
+ * Copyright 2023 Spiegel
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
@@ -0,0 +1,60 @@
+package fireball
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/goark/apod/internal/testutil"
+	"github.com/goark/apod/nasaapi"
+)
+
+func TestGet(t *testing.T) {
+	var query string
+	u, _ := testutil.NewServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != Path {
+			http.NotFound(w, r)
+			return
+		}
+		query = r.URL.RawQuery
+		_, _ = w.Write([]byte(`{"signature":{"source":"NASA/JPL Fireball Data API","version":"1.0"},"count":"1","fields":["date","energy","impact-e","lat","lat-dir","lon","lon-dir","alt","vel"],"data":[["2023-03-25 10:01:23","2.6","0.095","48.1","N","135.7","E","31.5",null]]}`))
+	})
+	req := New(WithDateRange("2023-01-01", ""), WithRequired(true, false, false), WithSort("-energy"), WithLimit(5), WithBaseURL(u), WithClient(nasaapi.NewClient(nasaapi.WithKeyLimiter(nil))))
+
+	list, err := req.Get(context.Background())
+	if err != nil {
+		t.Fatalf("Get() is \"%v\", want nil", err)
+	}
+	if want := "date-min=2023-01-01&limit=5&req-loc=true&sort=-energy"; query != want {
+		t.Errorf("query is \"%v\", want \"%v\"", query, want)
+	}
+	if len(list) != 1 {
+		t.Fatalf("Get() is %v, want 1 fireball", list)
+	}
+	if f := list[0]; f.Date != "2023-03-25 10:01:23" || f.Energy.Value != 2.6 || f.LatDir != "N" || f.Vel.Valid {
+		t.Errorf("Get() is %+v", f)
+	}
+}
+
+/* MIT License
+ *
+ * Copyright 2023 Spiegel
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
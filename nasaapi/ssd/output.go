@@ -0,0 +1,115 @@
+package ssd
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/goark/apod/ecode"
+	"github.com/goark/errs"
+)
+
+// Rows returns header and rows of list (slice of struct or pointer to struct) for table output.
+// Names of columns are json tags of struct fields.
+func Rows(list interface{}) ([]string, [][]string, error) {
+	rv := reflect.ValueOf(list)
+	if rv.Kind() != reflect.Slice {
+		return nil, nil, errs.Wrap(ecode.ErrInvalidParameter, errs.WithContext("type", fmt.Sprintf("%T", list)))
+	}
+	elemType := rv.Type().Elem()
+	if elemType.Kind() == reflect.Pointer {
+		elemType = elemType.Elem()
+	}
+	if elemType.Kind() != reflect.Struct {
+		return nil, nil, errs.Wrap(ecode.ErrInvalidParameter, errs.WithContext("type", fmt.Sprintf("%T", list)))
+	}
+	var header []string
+	var index []int
+	for i := 0; i < elemType.NumField(); i++ {
+		f := elemType.Field(i)
+		if !f.IsExported() {
+			continue
+		}
+		name := strings.Split(f.Tag.Get("json"), ",")[0]
+		if name == "-" {
+			continue
+		}
+		if len(name) == 0 {
+			name = f.Name
+		}
+		header = append(header, name)
+		index = append(index, i)
+	}
+	rows := make([][]string, 0, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		elem := rv.Index(i)
+		if elem.Kind() == reflect.Pointer {
+			if elem.IsNil() {
+				continue
+			}
+			elem = elem.Elem()
+		}
+		row := make([]string, 0, len(index))
+		for _, j := range index {
+			row = append(row, fmt.Sprint(elem.Field(j).Interface()))
+		}
+		rows = append(rows, row)
+	}
+	return header, rows, nil
+}
+
+// WriteCSV outputs list (slice of struct or pointer to struct) in CSV format.
+func WriteCSV(w io.Writer, list interface{}) error {
+	header, rows, err := Rows(list)
+	if err != nil {
+		return errs.Wrap(err)
+	}
+	cw := csv.NewWriter(w)
+	if err := cw.Write(header); err != nil {
+		return errs.Wrap(err)
+	}
+	if err := cw.WriteAll(rows); err != nil {
+		return errs.Wrap(err)
+	}
+	return nil
+}
+
+// WriteTable outputs list (slice of struct or pointer to struct) in text table format.
+func WriteTable(w io.Writer, list interface{}) error {
+	header, rows, err := Rows(list)
+	if err != nil {
+		return errs.Wrap(err)
+	}
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(tw, strings.ToUpper(strings.Join(header, "\t")))
+	for _, row := range rows {
+		fmt.Fprintln(tw, strings.Join(row, "\t"))
+	}
+	return errs.Wrap(tw.Flush())
+}
+
+/* MIT License
+ *
+ * Copyright 2023 Spiegel
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
@@ -0,0 +1,230 @@
+package sentry
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+
+	"github.com/goark/apod/ecode"
+	"github.com/goark/apod/nasaapi"
+	"github.com/goark/apod/nasaapi/ssd"
+	"github.com/goark/errs"
+)
+
+// Path is path of Sentry API.
+const Path = "/sentry.api"
+
+// Object is summary of object in Sentry impact risk table.
+type Object struct {
+	Des       string    `json:"des"`         // primary designation of the object
+	Fullname  string    `json:"fullname"`    // full designation of the object
+	ID        string    `json:"id"`          // object internal database ID
+	IP        ssd.Float `json:"ip"`          // cumulative impact probability
+	NImp      ssd.Int   `json:"n_imp"`       // number of potential impacts
+	PSCum     ssd.Float `json:"ps_cum"`      // cumulative Palermo scale
+	PSMax     ssd.Float `json:"ps_max"`      // maximum Palermo scale
+	TSMax     ssd.Int   `json:"ts_max"`      // maximum Torino scale
+	Range     string    `json:"range"`       // range of years of potential impacts
+	VInf      ssd.Float `json:"v_inf"`       // velocity at atmospheric entry (km/s)
+	H         ssd.Float `json:"h"`           // absolute magnitude (mag)
+	Diameter  ssd.Float `json:"diameter"`    // estimated diameter (km)
+	LastObs   string    `json:"last_obs"`    // date of last observation
+	LastObsJD ssd.Float `json:"last_obs_jd"` // date of last observation (JD)
+}
+
+// Summary is summary of the object in object mode.
+type Summary struct {
+	Des      string    `json:"des"`       // primary designation of the object
+	Fullname string    `json:"fullname"`  // full designation of the object
+	IP       ssd.Float `json:"ip"`        // cumulative impact probability
+	NImp     ssd.Int   `json:"n_imp"`     // number of potential impacts
+	PSCum    ssd.Float `json:"ps_cum"`    // cumulative Palermo scale
+	PSMax    ssd.Float `json:"ps_max"`    // maximum Palermo scale
+	TSMax    ssd.Int   `json:"ts_max"`    // maximum Torino scale
+	VInf     ssd.Float `json:"v_inf"`     // velocity at atmospheric entry (km/s)
+	VImp     ssd.Float `json:"v_imp"`     // velocity at impact (km/s)
+	H        ssd.Float `json:"h"`         // absolute magnitude (mag)
+	Diameter ssd.Float `json:"diameter"`  // estimated diameter (km)
+	Mass     ssd.Float `json:"mass"`      // estimated mass (kg)
+	Energy   ssd.Float `json:"energy"`    // estimated impact energy (Mt)
+	FirstObs string    `json:"first_obs"` // date of first observation
+	LastObs  string    `json:"last_obs"`  // date of last observation
+	NObs     ssd.Int   `json:"nobs"`      // number of observations
+	Darc     string    `json:"darc"`      // data arc span
+	Method   string    `json:"method"`    // analysis method
+	PDate    string    `json:"pdate"`     // date of the analysis
+	CDate    string    `json:"cdate"`     // date of the computation
+}
+
+// VirtualImpactor is virtual impactor of the object.
+type VirtualImpactor struct {
+	Date     string    `json:"date"`      // date of potential impact (TDB)
+	Energy   ssd.Float `json:"energy"`    // impact energy (Mt)
+	IP       ssd.Float `json:"ip"`        // impact probability
+	PS       ssd.Float `json:"ps"`        // Palermo scale
+	TS       ssd.Int   `json:"ts"`        // Torino scale
+	Dist     ssd.Float `json:"dist"`      // minimum distance from the LOV to Earth center (Earth radii)
+	Width    ssd.Float `json:"width"`     // width of uncertainty region (Earth radii)
+	SigmaVI  ssd.Float `json:"sigma_vi"`  // sigma of virtual impactor on the LOV
+	SigmaImp ssd.Float `json:"sigma_imp"` // sigma of impact
+	SigmaLOV ssd.Float `json:"sigma_lov"` // sigma of the LOV
+	Stretch  ssd.Float `json:"stretch"`   // stretching of uncertainty region (Earth radii)
+}
+
+// Detail is response data of Sentry API in object mode.
+type Detail struct {
+	Summary *Summary           `json:"summary"`
+	Data    []*VirtualImpactor `json:"data"`
+}
+
+type summaryResponse struct {
+	Count ssd.Int   `json:"count"`
+	Data  []*Object `json:"data"`
+}
+
+// Request is for context of Sentry API.
+type Request struct {
+	HMax  string `json:"h-max,omitempty"`  // exclude objects with H greater than this
+	PSMin string `json:"ps-min,omitempty"` // exclude objects with Palermo scale less than this
+	IPMin string `json:"ip-min,omitempty"` // exclude objects with impact probability less than this
+	Days  int    `json:"days,omitempty"`   // exclude objects not observed within this number of days
+	ssd.Config
+}
+
+type Opts func(*Request)
+
+// New returns new Request instance for Sentry API.
+func New(opts ...Opts) *Request {
+	ctx := &Request{}
+	for _, opt := range opts {
+		opt(ctx)
+	}
+	ctx.ResetClient()
+	return ctx
+}
+
+// WithHMax returns function for setting Request.HMax.
+func WithHMax(h string) Opts {
+	return func(ctx *Request) {
+		if ctx != nil {
+			ctx.HMax = h
+		}
+	}
+}
+
+// WithPSMin returns function for setting Request.PSMin.
+func WithPSMin(ps string) Opts {
+	return func(ctx *Request) {
+		if ctx != nil {
+			ctx.PSMin = ps
+		}
+	}
+}
+
+// WithIPMin returns function for setting Request.IPMin.
+func WithIPMin(ip string) Opts {
+	return func(ctx *Request) {
+		if ctx != nil {
+			ctx.IPMin = ip
+		}
+	}
+}
+
+// WithDays returns function for setting Request.Days.
+func WithDays(days int) Opts {
+	return func(ctx *Request) {
+		if ctx != nil {
+			ctx.Days = days
+		}
+	}
+}
+
+// WithBaseURL returns function for setting base URL of JPL SSD/CNEOS API.
+func WithBaseURL(u *url.URL) Opts {
+	return func(ctx *Request) {
+		if ctx != nil {
+			ctx.SetBaseURL(u)
+		}
+	}
+}
+
+// WithClient returns function for setting nasaapi.Client.
+func WithClient(cli *nasaapi.Client) Opts {
+	return func(ctx *Request) {
+		if ctx != nil {
+			ctx.SetClient(cli)
+		}
+	}
+}
+
+// Objects method gets summary of objects in Sentry impact risk table (summary mode).
+func (req *Request) Objects(ctx context.Context) ([]*Object, error) {
+	if req == nil {
+		return nil, errs.Wrap(ecode.ErrNullPointer)
+	}
+	q := url.Values{}
+	if len(req.HMax) > 0 {
+		q.Set("h-max", req.HMax)
+	}
+	if len(req.PSMin) > 0 {
+		q.Set("ps-min", req.PSMin)
+	}
+	if len(req.IPMin) > 0 {
+		q.Set("ip-min", req.IPMin)
+	}
+	if req.Days != 0 {
+		q.Set("days", strconv.Itoa(req.Days))
+	}
+	var resp summaryResponse
+	if err := req.Client().RequestJSON(ctx, Path, q, &resp); err != nil {
+		return nil, errs.Wrap(err)
+	}
+	if resp.Data == nil {
+		return []*Object{}, nil
+	}
+	return resp.Data, nil
+}
+
+// Object method gets detail of the object with virtual impactors (object mode).
+func (req *Request) Object(ctx context.Context, des string) (*Detail, error) {
+	if req == nil {
+		return nil, errs.Wrap(ecode.ErrNullPointer)
+	}
+	if len(des) == 0 {
+		return nil, errs.Wrap(ecode.ErrInvalidParameter, errs.WithContext("des", des))
+	}
+	var detail Detail
+	if err := req.Client().RequestJSON(ctx, Path, url.Values{"des": []string{des}}, &detail); err != nil {
+		return nil, errs.Wrap(err, errs.WithContext("des", des))
+	}
+	if detail.Summary == nil {
+		return nil, errs.Wrap(ecode.ErrNotFound, errs.WithContext("des", des))
+	}
+	if detail.Data == nil {
+		detail.Data = []*VirtualImpactor{}
+	}
+	return &detail, nil
+}
+
+/* MIT License
+ *
+ * Copyright 2023 Spiegel
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
@@ -0,0 +1,91 @@
+package sentry
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/goark/apod/ecode"
+	"github.com/goark/apod/internal/testutil"
+	"github.com/goark/apod/nasaapi"
+)
+
+func TestSentry(t *testing.T) {
+	var query string
+	u, _ := testutil.NewServer(t, func(w http.ResponseWriter, r *http.Request) {
+		query = r.URL.RawQuery
+		w.Header().Set(nasaapi.HeaderRateLimitLimit, "1000")
+		w.Header().Set(nasaapi.HeaderRateLimitRemaining, "999")
+		switch r.URL.Query().Get("des") {
+		case "":
+			_, _ = w.Write([]byte(`{"signature":{"source":"NASA/JPL Sentry Data API","version":"2.0"},"count":"1","data":[{"des":"29075","fullname":"29075 (1950 DA)","id":"a0029075","ip":"3.8e-4","n_imp":"1","ps_cum":"-0.93","ps_max":"-0.93","ts_max":null,"range":"2880-2880"}]}`))
+		case "29075":
+			_, _ = w.Write([]byte(`{"signature":{"source":"NASA/JPL Sentry Data API","version":"2.0"},"summary":{"des":"29075","fullname":"29075 (1950 DA)","ip":"3.8e-4","n_imp":"1","ps_cum":"-0.93","ps_max":"-0.93","ts_max":null},"data":[{"date":"2880-03-16.84","energy":"7.5e+04","ip":"3.8e-4","ps":"-0.93","ts":null}]}`))
+		default:
+			_, _ = w.Write([]byte(`{"signature":{"source":"NASA/JPL Sentry Data API","version":"2.0"},"error":"specified object not found"}`))
+		}
+	})
+	// base URL of nasaapi.Client is not used
+	req := New(WithHMax("22"), WithDays(-7), WithBaseURL(u), WithClient(nasaapi.NewClient(nasaapi.WithKeyLimiter(nil))))
+
+	objs, err := req.Objects(context.Background())
+	if err != nil {
+		t.Fatalf("Objects() is \"%v\", want nil", err)
+	}
+	if want := "days=-7&h-max=22"; query != want {
+		t.Errorf("query of Objects() is \"%v\", want \"%v\"", query, want)
+	}
+	if len(objs) != 1 || objs[0].Des != "29075" || objs[0].IP.Value != 3.8e-4 || objs[0].TSMax.Valid {
+		t.Errorf("Objects() is %+v", objs)
+	}
+	if rl, ok := req.Client().RateLimit(); !ok || rl.Remaining != 999 {
+		t.Errorf("Client().RateLimit() is (%v, %v), want remaining 999", rl, ok)
+	}
+
+	testCases := []struct {
+		des   string
+		query string
+		err   error
+	}{
+		{des: "29075", query: "des=29075", err: nil},
+		{des: "101955", query: "des=101955", err: ecode.ErrNotFound},
+		{des: "", query: "", err: ecode.ErrInvalidParameter},
+	}
+	for _, tc := range testCases {
+		query = ""
+		detail, err := req.Object(context.Background(), tc.des)
+		if !errors.Is(err, tc.err) {
+			t.Errorf("Object(%v) is \"%v\", want \"%v\"", tc.des, err, tc.err)
+		}
+		if query != tc.query {
+			t.Errorf("query of Object(%v) is \"%v\", want \"%v\"", tc.des, query, tc.query)
+		}
+		if err == nil && (detail.Summary.Fullname != "29075 (1950 DA)" || len(detail.Data) != 1 || detail.Data[0].Date != "2880-03-16.84") {
+			t.Errorf("Object(%v) is %+v", tc.des, detail)
+		}
+	}
+}
+
+/* MIT License
+ *
+ * Copyright 2023 Spiegel
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
@@ -0,0 +1,80 @@
+package ssd
+
+import (
+	"encoding/json"
+	"reflect"
+
+	"github.com/goark/apod/ecode"
+	"github.com/goark/errs"
+)
+
+// Signature is signature of SSD/CNEOS API response.
+type Signature struct {
+	Source  string `json:"source"`
+	Version string `json:"version"`
+}
+
+// Table is response data in "fields" and "data" table format of SSD/CNEOS API.
+type Table struct {
+	Signature Signature           `json:"signature"`
+	Count     Int                 `json:"count"`
+	Fields    []string            `json:"fields"`
+	Data      [][]json.RawMessage `json:"data"`
+}
+
+// Decode method decodes rows of table into v (pointer to slice of struct).
+// Each row is mapped to struct fields by json tag with the field name.
+func (t *Table) Decode(v interface{}) error {
+	if t == nil {
+		return errs.Wrap(ecode.ErrNullPointer)
+	}
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Pointer || rv.IsNil() || rv.Elem().Kind() != reflect.Slice {
+		return errs.Wrap(ecode.ErrInvalidParameter, errs.WithContext("type", rv.Type().String()))
+	}
+	list := rv.Elem()
+	elemType := list.Type().Elem()
+	list.Set(reflect.MakeSlice(list.Type(), 0, len(t.Data)))
+	for i, row := range t.Data {
+		if len(row) != len(t.Fields) {
+			return errs.Wrap(ecode.ErrInvalidParameter, errs.WithContext("row", i), errs.WithContext("fields", len(t.Fields)), errs.WithContext("values", len(row)))
+		}
+		obj := make(map[string]json.RawMessage, len(row))
+		for j, value := range row {
+			obj[t.Fields[j]] = value
+		}
+		b, err := json.Marshal(obj)
+		if err != nil {
+			return errs.Wrap(err, errs.WithContext("row", i))
+		}
+		elem := reflect.New(elemType)
+		if err := json.Unmarshal(b, elem.Interface()); err != nil {
+			return errs.Wrap(err, errs.WithContext("row", i))
+		}
+		list.Set(reflect.Append(list, elem.Elem()))
+	}
+	return nil
+}
+
+/* MIT License
+ *
+ * Copyright 2023 Spiegel
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
@@ -0,0 +1,82 @@
+package ssd
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/goark/apod/ecode"
+)
+
+type testRow struct {
+	Des    string `json:"des"`
+	Dist   Float  `json:"dist"`
+	Count  Int    `json:"n"`
+	Ignore string `json:"-"`
+}
+
+const testTable = `{"signature":{"source":"test","version":"1.0"},"count":"2","fields":["des","dist","n"],"data":[["2023 DZ2","0.00116",3],["433",null,""]]}`
+
+func TestDecode(t *testing.T) {
+	var tbl Table
+	if err := json.Unmarshal([]byte(testTable), &tbl); err != nil {
+		t.Fatalf("json.Unmarshal() is \"%v\", want nil", err)
+	}
+	if tbl.Count.Value != 2 {
+		t.Errorf("Table.Count is \"%v\", want \"%v\"", tbl.Count, 2)
+	}
+	var rows []*testRow
+	if err := tbl.Decode(&rows); err != nil {
+		t.Fatalf("Table.Decode() is \"%v\", want nil", err)
+	}
+	if len(rows) != 2 {
+		t.Fatalf("Table.Decode() is %v, want 2 rows", rows)
+	}
+	if rows[0].Des != "2023 DZ2" || rows[0].Dist != (Float{Value: 0.00116, Valid: true}) || rows[0].Count != (Int{Value: 3, Valid: true}) {
+		t.Errorf("row[0] is %+v", rows[0])
+	}
+	if rows[1].Des != "433" || rows[1].Dist.Valid || rows[1].Count.Valid {
+		t.Errorf("row[1] is %+v", rows[1])
+	}
+	b, _ := json.Marshal(rows[1])
+	if got, want := string(b), `{"des":"433","dist":null,"n":null}`; got != want {
+		t.Errorf("json.Marshal() is \"%v\", want \"%v\"", got, want)
+	}
+
+	buf := &bytes.Buffer{}
+	if err := WriteCSV(buf, rows); err != nil {
+		t.Errorf("WriteCSV() is \"%v\", want nil", err)
+	}
+	if got, want := buf.String(), "des,dist,n\n2023 DZ2,0.00116,3\n433,,\n"; got != want {
+		t.Errorf("WriteCSV() is \"%v\", want \"%v\"", got, want)
+	}
+
+	var bad []string
+	if err := tbl.Decode(bad); !errors.Is(err, ecode.ErrInvalidParameter) {
+		t.Errorf("Table.Decode() is \"%v\", want \"%v\"", err, ecode.ErrInvalidParameter)
+	}
+}
+
+/* MIT License
+ *
+ * Copyright 2023 Spiegel
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
@@ -0,0 +1,121 @@
+package ssd
+
+import (
+	"bytes"
+	"strconv"
+
+	"github.com/goark/errs"
+)
+
+// Float is floating-point number in SSD/CNEOS API (number in string or null).
+type Float struct {
+	Value float64
+	Valid bool // false if value is null
+}
+
+// String is Stringer method. If value is null, returns empty string.
+func (f Float) String() string {
+	if !f.Valid {
+		return ""
+	}
+	return strconv.FormatFloat(f.Value, 'f', -1, 64)
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (f Float) MarshalJSON() ([]byte, error) {
+	if !f.Valid {
+		return []byte("null"), nil
+	}
+	return []byte(f.String()), nil
+}
+
+// UnmarshalJSON implements the json.UnmarshalJSON interface.
+func (f *Float) UnmarshalJSON(b []byte) error {
+	s, ok := unquote(b)
+	if !ok {
+		*f = Float{}
+		return nil
+	}
+	v, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return errs.Wrap(err, errs.WithContext("value", string(b)))
+	}
+	*f = Float{Value: v, Valid: true}
+	return nil
+}
+
+// Int is integer in SSD/CNEOS API (number in string or null).
+type Int struct {
+	Value int64
+	Valid bool // false if value is null
+}
+
+// String is Stringer method. If value is null, returns empty string.
+func (i Int) String() string {
+	if !i.Valid {
+		return ""
+	}
+	return strconv.FormatInt(i.Value, 10)
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (i Int) MarshalJSON() ([]byte, error) {
+	if !i.Valid {
+		return []byte("null"), nil
+	}
+	return []byte(i.String()), nil
+}
+
+// UnmarshalJSON implements the json.UnmarshalJSON interface.
+func (i *Int) UnmarshalJSON(b []byte) error {
+	s, ok := unquote(b)
+	if !ok {
+		*i = Int{}
+		return nil
+	}
+	v, err := strconv.ParseInt(s, 10, 64)
+	if err != nil {
+		return errs.Wrap(err, errs.WithContext("value", string(b)))
+	}
+	*i = Int{Value: v, Valid: true}
+	return nil
+}
+
+// unquote returns string of JSON value (quoted or not). If value is null or empty, returns false.
+func unquote(b []byte) (string, bool) {
+	b = bytes.TrimSpace(b)
+	if len(b) == 0 || bytes.Equal(b, []byte("null")) {
+		return "", false
+	}
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		s = string(b)
+	}
+	if len(s) == 0 {
+		return "", false
+	}
+	return s, true
+}
+
+/* MIT License
+ *
+ * Copyright 2023 Spiegel
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
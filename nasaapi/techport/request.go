@@ -0,0 +1,133 @@
+package techport
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/goark/apod/ecode"
+	"github.com/goark/apod/nasaapi"
+	"github.com/goark/errs"
+)
+
+const ProjectsPath = "/techport/api/projects" // path of TechPort projects API
+
+// Request is for context of TechPort API.
+type Request struct {
+	UpdatedSince nasaapi.Date `json:"updatedSince,omitempty"` // list projects updated since the date
+	APIKey       string       `json:"api_key"`                // api.nasa.gov key for expanded usage
+	client       *nasaapi.Client
+}
+
+type Opts func(*Request)
+
+// New returns new Request instance for TechPort API.
+func New(opts ...Opts) *Request {
+	ctx := &Request{}
+	for _, opt := range opts {
+		opt(ctx)
+	}
+	return ctx
+}
+
+// WithUpdatedSince returns function for setting Request.UpdatedSince.
+func WithUpdatedSince(date nasaapi.Date) Opts {
+	return func(ctx *Request) {
+		if ctx != nil {
+			ctx.UpdatedSince = date
+		}
+	}
+}
+
+// WithAPIKey returns function for setting Request.APIKey.
+func WithAPIKey(apiKey string) Opts {
+	return func(ctx *Request) {
+		if ctx != nil {
+			ctx.APIKey = apiKey
+		}
+	}
+}
+
+// WithClient returns function for setting nasaapi.Client.
+func WithClient(client *nasaapi.Client) Opts {
+	return func(ctx *Request) {
+		if ctx != nil {
+			ctx.client = client
+		}
+	}
+}
+
+// Client method returns nasaapi.Client instance for requesting. If not set, returns nasaapi.DefaultClient().
+func (req *Request) Client() *nasaapi.Client {
+	if req == nil || req.client == nil {
+		return nasaapi.DefaultClient()
+	}
+	return req.client
+}
+
+// Projects method gets list of projects updated since Request.UpdatedSince (all projects if zero).
+func (req *Request) Projects(ctx context.Context) (*Projects, error) {
+	if req == nil {
+		return nil, errs.Wrap(ecode.ErrNullPointer)
+	}
+	q := req.query()
+	if !req.UpdatedSince.IsZero() {
+		q.Set("updatedSince", req.UpdatedSince.Format(time.DateOnly))
+	}
+	projects := &Projects{}
+	if err := req.Client().RequestJSON(ctx, ProjectsPath, q, projects); err != nil {
+		return nil, errs.Wrap(err, errs.WithContext("updatedSince", req.UpdatedSince))
+	}
+	if projects.Projects == nil {
+		projects.Projects = []*ProjectSummary{}
+	}
+	return projects, nil
+}
+
+// Project method gets details of the project.
+func (req *Request) Project(ctx context.Context, id int) (*Project, error) {
+	if req == nil {
+		return nil, errs.Wrap(ecode.ErrNullPointer)
+	}
+	if id <= 0 {
+		return nil, errs.Wrap(ecode.ErrInvalidParameter, errs.WithContext("id", id))
+	}
+	var resp projectResponse
+	if err := req.Client().RequestJSON(ctx, ProjectsPath+"/"+strconv.Itoa(id), req.query(), &resp); err != nil {
+		return nil, errs.Wrap(err, errs.WithContext("id", id))
+	}
+	if resp.Project == nil {
+		return nil, errs.Wrap(ecode.ErrNotFound, errs.WithContext("id", id))
+	}
+	return resp.Project, nil
+}
+
+func (req *Request) query() url.Values {
+	q := url.Values{}
+	q.Set("api_key", nasaapi.APIKey(req.APIKey))
+	return q
+}
+
+/* MIT License
+ *
+ * Copyright 2023 Spiegel
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
@@ -0,0 +1,87 @@
+package techport
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/goark/apod/ecode"
+	"github.com/goark/apod/internal/testutil"
+)
+
+func TestTechPort(t *testing.T) {
+	var query string
+	_, cli := testutil.NewServer(t, func(w http.ResponseWriter, r *http.Request) {
+		query = r.URL.RawQuery
+		switch r.URL.Path {
+		case ProjectsPath:
+			_, _ = w.Write([]byte(`{"projects":[{"projectId":94234,"title":"Foo","lastUpdated":"2021-2-23"},{"projectId":17792,"lastUpdated":"2021-3-1"}],"totalCount":2}`))
+		case ProjectsPath + "/17792":
+			_, _ = w.Write([]byte(`{"project":{"projectId":17792,"title":"Bar","statusDescription":"Active","trlBegin":2,"trlCurrent":3,"program":{"title":"SBIR/STTR","acronym":"SBIR"},"leadOrganization":{"organizationName":"NASA JPL","stateTerritory":{"abbreviation":"CA"}}}}`))
+		case ProjectsPath + "/1":
+			_, _ = w.Write([]byte(`{}`))
+		default:
+			http.NotFound(w, r)
+		}
+	})
+
+	projects, err := New(WithClient(cli), WithUpdatedSince(testutil.DateFromMust(t, "2021-02-01")), WithAPIKey("foo")).Projects(context.Background())
+	if err != nil {
+		t.Fatalf("Projects() is \"%v\", want nil", err)
+	}
+	if want := "api_key=foo&updatedSince=2021-02-01"; query != want {
+		t.Errorf("query is \"%v\", want \"%v\"", query, want)
+	}
+	if projects.TotalCount != 2 || len(projects.Projects) != 2 || projects.Projects[1].ProjectID != 17792 {
+		t.Errorf("Projects() is %+v", projects)
+	}
+
+	testCases := []struct {
+		id    int
+		title string
+		err   error
+	}{
+		{id: 17792, title: "Bar"},
+		{id: 1, err: ecode.ErrNotFound},
+		{id: 0, err: ecode.ErrInvalidParameter},
+		{id: 2, err: ecode.ErrNotFound},
+	}
+	for _, tc := range testCases {
+		query = ""
+		p, err := New(WithClient(cli), WithUpdatedSince(testutil.DateFromMust(t, "2021-02-01"))).Project(context.Background(), tc.id)
+		if tc.id > 0 && query != "api_key=DEMO_KEY" {
+			t.Errorf("query of Project(%v) is \"%v\", want \"%v\"", tc.id, query, "api_key=DEMO_KEY")
+		}
+		if !errors.Is(err, tc.err) {
+			t.Errorf("Project(%v) error is \"%v\", want \"%v\"", tc.id, err, tc.err)
+			continue
+		}
+		if err == nil && (p.Title != tc.title || p.Program.Acronym != "SBIR" || p.LeadOrganization.State.Abbreviation != "CA") {
+			t.Errorf("Project(%v) is %+v", tc.id, p)
+		}
+	}
+}
+
+/* MIT License
+ *
+ * Copyright 2023 Spiegel
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
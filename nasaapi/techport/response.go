@@ -0,0 +1,98 @@
+package techport
+
+// Projects is response of TechPort projects list API.
+type Projects struct {
+	Projects   []*ProjectSummary `json:"projects"`
+	TotalCount int               `json:"totalCount"`
+}
+
+// ProjectSummary is element of projects list.
+type ProjectSummary struct {
+	ProjectID   int    `json:"projectId"`
+	Title       string `json:"title,omitempty"`
+	Acronym     string `json:"acronym,omitempty"`
+	LastUpdated string `json:"lastUpdated"` // e.g. "2021-2-23"
+}
+
+// projectResponse is response of TechPort project API.
+type projectResponse struct {
+	Project *Project `json:"project"`
+}
+
+// Project is details of TechPort project.
+type Project struct {
+	ProjectID              int           `json:"projectId"`
+	Title                  string        `json:"title"`
+	Acronym                string        `json:"acronym,omitempty"`
+	Description            string        `json:"description,omitempty"`
+	Benefits               string        `json:"benefits,omitempty"`
+	StatusDescription      string        `json:"statusDescription,omitempty"`
+	ReleaseStatusString    string        `json:"releaseStatusString,omitempty"`
+	StartDateString        string        `json:"startDateString,omitempty"`
+	EndDateString          string        `json:"endDateString,omitempty"`
+	LastUpdated            string        `json:"lastUpdated,omitempty"`
+	Website                string        `json:"website,omitempty"`
+	TRLBegin               int           `json:"trlBegin,omitempty"`
+	TRLCurrent             int           `json:"trlCurrent,omitempty"`
+	TRLEnd                 int           `json:"trlEnd,omitempty"`
+	Program                *Program      `json:"program,omitempty"`
+	LeadOrganization       *Organization `json:"leadOrganization,omitempty"`
+	ProgramDirectors       []*Contact    `json:"programDirectors,omitempty"`
+	ProgramManagers        []*Contact    `json:"programManagers,omitempty"`
+	ProjectManagers        []*Contact    `json:"projectManagers,omitempty"`
+	PrincipalInvestigators []*Contact    `json:"principalInvestigators,omitempty"`
+}
+
+// Program is NASA program of project.
+type Program struct {
+	ProgramID   int    `json:"programId,omitempty"`
+	Title       string `json:"title"`
+	Acronym     string `json:"acronym,omitempty"`
+	Description string `json:"description,omitempty"`
+}
+
+// Organization is organization of project.
+type Organization struct {
+	OrganizationID   int    `json:"organizationId,omitempty"`
+	OrganizationName string `json:"organizationName"`
+	OrganizationType string `json:"organizationType,omitempty"`
+	City             string `json:"city,omitempty"`
+	State            *State `json:"stateTerritory,omitempty"`
+	URL              string `json:"url,omitempty"`
+}
+
+// State is state or territory of organization.
+type State struct {
+	Abbreviation string `json:"abbreviation,omitempty"`
+	Name         string `json:"name,omitempty"`
+}
+
+// Contact is person of project.
+type Contact struct {
+	ContactID int    `json:"contactId,omitempty"`
+	FullName  string `json:"fullName"`
+	Email     string `json:"primaryEmail,omitempty"`
+}
+
+/* MIT License
+ *
+ * Copyright 2023 Spiegel
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
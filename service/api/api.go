@@ -0,0 +1,115 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/url"
+	"strings"
+
+	"github.com/goark/apod/ecode"
+	"github.com/goark/apod/nasaapi"
+	"github.com/goark/errs"
+)
+
+// API is configuration for api command.
+type API struct {
+	cli     *nasaapi.Client
+	path    string
+	query   url.Values
+	rawFlag bool
+}
+
+// New returns new API instance.
+// params are "key=value" strings of query parameters, and api_key parameter is set by apiKey if params does not have it.
+func New(cli *nasaapi.Client, apiKey, method, path string, params []string, rawFlag bool) (*API, error) {
+	if !strings.EqualFold(method, "GET") {
+		return nil, errs.Wrap(ecode.ErrInvalidParameter, errs.WithContext("method", method))
+	}
+	if u, err := url.Parse(path); err != nil || u.IsAbs() || len(u.Host) > 0 || len(u.RawQuery) > 0 {
+		return nil, errs.Wrap(ecode.ErrInvalidParameter, errs.WithContext("path", path))
+	}
+	if !strings.HasPrefix(path, "/") {
+		path = "/" + path
+	}
+	q, err := ParseParams(params)
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	if !q.Has("api_key") {
+		q.Set("api_key", nasaapi.APIKey(apiKey))
+	}
+	return &API{cli: cli, path: path, query: q, rawFlag: rawFlag}, nil
+}
+
+// ParseParams function parses "key=value" strings into query parameters.
+// Same key may be given repeatedly.
+func ParseParams(params []string) (url.Values, error) {
+	q := url.Values{}
+	for _, param := range params {
+		key, value, ok := strings.Cut(param, "=")
+		if !ok || len(key) == 0 {
+			return nil, errs.Wrap(ecode.ErrInvalidParameter, errs.WithContext("param", param))
+		}
+		q.Add(key, value)
+	}
+	return q, nil
+}
+
+// Client method returns nasaapi.Client instance for requesting.
+func (a *API) Client() *nasaapi.Client {
+	if a == nil || a.cli == nil {
+		return nasaapi.DefaultClient()
+	}
+	return a.cli
+}
+
+// Do method requests to the path of NASA API, and returns response data.
+// JSON data is pretty-printed unless raw flag is set; other data is passed through.
+func (a *API) Do(ctx context.Context) (io.Reader, error) {
+	if a == nil {
+		return nil, errs.Wrap(ecode.ErrNullPointer)
+	}
+	r, err := a.Client().Request(ctx, a.path, a.query)
+	if err != nil {
+		return nil, errs.Wrap(err, errs.WithContext("path", a.path))
+	}
+	defer r.Close()
+	b, err := io.ReadAll(r)
+	if err != nil {
+		return nil, errs.Wrap(err, errs.WithContext("path", a.path))
+	}
+	if a.rawFlag || !json.Valid(b) {
+		return bytes.NewReader(b), nil
+	}
+	buf := &bytes.Buffer{}
+	if err := json.Indent(buf, b, "", "  "); err != nil {
+		return nil, errs.Wrap(err, errs.WithContext("path", a.path))
+	}
+	buf.WriteByte('\n')
+	return buf, nil
+}
+
+/* MIT License
+ *
+ * Copyright 2023 Spiegel
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
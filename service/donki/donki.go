@@ -0,0 +1,117 @@
+package donki
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/goark/apod/ecode"
+	"github.com/goark/apod/nasaapi/donki"
+	"github.com/goark/errs"
+)
+
+// Format is output format of donki command.
+type Format int
+
+const (
+	FormatJSON  Format = iota // typed JSON data
+	FormatTable               // table of event summaries
+	FormatRaw                 // raw data from DONKI API
+)
+
+var formatNames = map[Format]string{
+	FormatJSON:  "json",
+	FormatTable: "table",
+	FormatRaw:   "raw",
+}
+
+// FormatFrom returns Format from string.
+func FormatFrom(s string) (Format, error) {
+	for f, name := range formatNames {
+		if strings.EqualFold(name, s) {
+			return f, nil
+		}
+	}
+	return FormatJSON, errs.Wrap(ecode.ErrInvalidParameter, errs.WithContext("format", s))
+}
+
+// String is Stringer method.
+func (f Format) String() string {
+	if name, ok := formatNames[f]; ok {
+		return name
+	}
+	return fmt.Sprintf("unknown (%d)", int(f))
+}
+
+// Donki is configuration for donki command.
+type Donki struct {
+	*donki.Request
+	typ    donki.EventType
+	format Format
+}
+
+// New returns new Donki instance.
+func New(cfg *donki.Request, typ donki.EventType, format Format) *Donki {
+	return &Donki{Request: cfg, typ: typ, format: format}
+}
+
+// Do method lists DONKI events from NASA API.
+func (d *Donki) Do(ctx context.Context) (io.ReadCloser, error) {
+	if d == nil || d.Request == nil {
+		return nil, errs.Wrap(ecode.ErrNullPointer)
+	}
+	if d.format == FormatRaw {
+		return d.GetRawData(ctx, d.typ)
+	}
+	events, err := d.Events(ctx, d.typ)
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	buf := &bytes.Buffer{}
+	switch d.format {
+	case FormatTable:
+		tw := tabwriter.NewWriter(buf, 0, 4, 2, ' ', 0)
+		fmt.Fprintln(tw, "TIME\tID\tDETAIL")
+		for _, e := range events {
+			s := e.Summary()
+			fmt.Fprintf(tw, "%v\t%s\t%s\n", s.Time, s.ID, s.Detail)
+		}
+		if err := tw.Flush(); err != nil {
+			return nil, errs.Wrap(err)
+		}
+	default:
+		b, err := json.Marshal(events)
+		if err != nil {
+			return nil, errs.Wrap(err)
+		}
+		buf.Write(b)
+	}
+	return io.NopCloser(buf), nil
+}
+
+/* MIT License
+ *
+ * Copyright 2023 Spiegel
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
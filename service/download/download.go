@@ -4,18 +4,22 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
-	"io"
+	"net/url"
 	"os"
 	"path"
 	"path/filepath"
+	"sort"
+	"strings"
 
 	"github.com/goark/apod/ecode"
+	"github.com/goark/apod/internal/pool"
+	"github.com/goark/apod/nasaapi"
 	"github.com/goark/apod/nasaapi/apod"
 	"github.com/goark/errs"
 	"github.com/goark/fetch"
 )
 
-const maxDataSize = 1024 * 1024 * 1024 //1GB
+const maxDataSize = 1024 * 1024 * 1024 //1GB (default maximum size of a file)
 
 // Download is configuration for download command.
 type Download struct {
@@ -23,87 +27,212 @@ type Download struct {
 	baseDir       string
 	copyrightFlag bool
 	overwriteFlag bool
+	jobs          int
+	maxSize       int64
+	keepGoing     bool
+	report        *Report
 }
 
+type Opts func(*Download)
+
 // New returns new Lookup instance.
-func New(cfg *apod.Request, baseDir string, copyrightFlag, overwriteFlag bool) *Download {
+func New(cfg *apod.Request, baseDir string, copyrightFlag, overwriteFlag bool, opts ...Opts) *Download {
 	if len(baseDir) == 0 {
 		baseDir = "."
 	}
-	return &Download{
+	dl := &Download{
 		Request:       cfg,
 		baseDir:       baseDir,
 		copyrightFlag: copyrightFlag,
 		overwriteFlag: overwriteFlag,
+		jobs:          1,
+	}
+	for _, opt := range opts {
+		opt(dl)
+	}
+	return dl
+}
+
+// WithJobs returns function for setting maximum number of dates downloaded concurrently (less than 1 is 1).
+func WithJobs(n int) Opts {
+	return func(dl *Download) {
+		if dl != nil {
+			dl.jobs = n
+		}
+	}
+}
+
+// WithMaxSize returns function for setting maximum size of a downloaded file in bytes (not positive is 1GB).
+func WithMaxSize(size int64) Opts {
+	return func(dl *Download) {
+		if dl != nil {
+			dl.maxSize = size
+		}
+	}
+}
+
+// WithKeepGoing returns function for setting keep-going mode.
+// In keep-going mode, a failed date does not stop downloading the other dates.
+func WithKeepGoing(flag bool) Opts {
+	return func(dl *Download) {
+		if dl != nil {
+			dl.keepGoing = flag
+		}
 	}
 }
 
 // Do method is downloading APOD data from NASA API.
+// Outcome of each date is available by Report method after calling Do method.
+// In keep-going mode, Do method returns ecode.ErrPartialDownload error if some dates failed and the others succeeded,
+// and dates of failed chunks of long date range (see apod.ChunkError) are reported as failed.
 func (dl *Download) Do(ctx context.Context) error {
 	if dl == nil {
 		return errs.Wrap(ecode.ErrNullPointer)
 	}
 	// get APOD data from NASA API
-	resps, err := dl.Get(ctx)
-	if err != nil {
-		return errs.Wrap(err)
+	resps, getErr := dl.Get(ctx)
+	var chunkErr *apod.ChunkError
+	if getErr != nil && (!dl.keepGoing || !errors.As(getErr, &chunkErr)) {
+		return errs.Wrap(getErr)
 	}
 
 	// make directory
-	if _, err := os.Stat(dl.baseDir); err != nil { // dirextory is not found
-		if err := os.MkdirAll(dl.baseDir, os.ModePerm); err != nil {
-			return errs.Wrap(err)
+	if err := makeBaseDir(dl.baseDir); err != nil {
+		return errs.Wrap(err)
+	}
+
+	// download files for each date with worker pool
+	// (files of the same date are downloaded sequentially by one worker)
+	jobCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	statusList := make([]Status, len(resps))
+	errList := pool.Run(jobCtx, dl.jobs, len(resps), func(ctx context.Context, i int) error {
+		status, err := dl.downloadDate(ctx, resps[i])
+		if err != nil && !dl.keepGoing {
+			cancel() // stop other dates
 		}
+		statusList[i] = status
+		return err
+	})
+
+	// make report
+	outcomes := make([]*Outcome, len(resps))
+	for i, resp := range resps {
+		outcomes[i] = newOutcome(resp.Date, filepath.Join(dl.baseDir, resp.Date.String()), statusList[i], errList[i])
 	}
+	outcomes = append(outcomes, dl.chunkOutcomes(chunkErr)...)
+	sort.SliceStable(outcomes, func(i, j int) bool { return outcomes[i].Date.Before(outcomes[j].Date.Time) })
+	dl.report = newReport(outcomes)
 
-	for _, resp := range resps {
-		// make directory
-		dir := filepath.Join(dl.baseDir, resp.Date.String())
-		if _, err := os.Stat(dir); err != nil { // dirextory is not found
-			if err := os.Mkdir(dir, os.ModePerm); err != nil {
-				return errs.Wrap(err, errs.WithContext("dir", dir))
-			}
-		} else if !dl.overwriteFlag {
+	if dl.keepGoing && ctx.Err() == nil && dl.report.Partial() {
+		return errs.Wrap(ecode.ErrPartialDownload, errs.WithContext("failed", dl.report.Summary.Failed), errs.WithContext("total", dl.report.Summary.Total))
+	}
+	if err := firstError(ctx, errList, func(i int) string { return resps[i].Date.String() }); err != nil {
+		return err
+	}
+	return errs.Wrap(getErr)
+}
+
+// chunkOutcomes method returns failed outcomes of each date in failed chunks.
+func (dl *Download) chunkOutcomes(chunkErr *apod.ChunkError) []*Outcome {
+	if chunkErr == nil {
+		return nil
+	}
+	var outcomes []*Outcome
+	for _, f := range chunkErr.Failures {
+		if f.StartDate.IsZero() {
 			continue
-		} else {
-			if err := os.RemoveAll(dir); err != nil {
-				return errs.Wrap(err, errs.WithContext("dir", dir))
-			}
-			if err := os.Mkdir(dir, 0755); err != nil {
-				return errs.Wrap(err, errs.WithContext("dir", dir))
-			}
 		}
-
-		// output metadata.json file
-		if err := saveMetadate(resp, filepath.Join(dir, "metadata.json")); err != nil {
-			return errs.Wrap(err)
+		end := f.EndDate
+		if end.IsZero() {
+			end = nasaapi.Today()
 		}
-		// download image/video files
-		if len(resp.Copyright) > 0 && !dl.copyrightFlag {
-			continue
+		for date := f.StartDate; !date.After(end.Time); date = nasaapi.NewDate(date.AddDate(0, 0, 1)) {
+			outcomes = append(outcomes, newOutcome(date, filepath.Join(dl.baseDir, date.String()), StatusFailed, f.Err))
 		}
+	}
+	return outcomes
+}
+
+// Report method returns outcome of each date in the last call of Do method (nil if not downloaded).
+func (dl *Download) Report() *Report {
+	if dl == nil {
+		return nil
+	}
+	return dl.report
+}
+
+// downloadDate method downloads metadata.json and image/video files of APOD data into <base dir>/<date>/ directory.
+func (dl *Download) downloadDate(ctx context.Context, resp *apod.Response) (Status, error) {
+	// make directory
+	dir := filepath.Join(dl.baseDir, resp.Date.String())
+	work, ok, err := prepareDir(dir, dl.overwriteFlag)
+	if err != nil {
+		return StatusFailed, errs.Wrap(err)
+	} else if !ok {
+		return StatusSkippedExisting, nil
+	}
+
+	// output metadata.json file
+	if err := saveMetadata(resp, filepath.Join(work, "metadata.json")); err != nil {
+		return StatusFailed, errs.Wrap(err)
+	}
+	// download image/video files
+	status := StatusSkippedCopyright
+	if len(resp.Copyright) == 0 || dl.copyrightFlag {
+		status = StatusDownloaded
 		if len(resp.HdUrl) > 0 {
-			if err := downloadImage(ctx, resp.HdUrl, dir); err != nil {
-				return errs.Wrap(err, errs.WithContext("hdUrl", resp.HdUrl))
+			if err := downloadImage(ctx, dl.Client(), resp.HdUrl, work, dl.maxSize); err != nil {
+				return StatusFailed, errs.Wrap(err, errs.WithContext("hdUrl", resp.HdUrl))
 			}
 		}
 		if len(resp.Url) > 0 {
-			if err := downloadImage(ctx, resp.Url, dir); err != nil {
-				return errs.Wrap(err, errs.WithContext("url", resp.Url))
+			if err := downloadImage(ctx, dl.Client(), resp.Url, work, dl.maxSize); err != nil {
+				return StatusFailed, errs.Wrap(err, errs.WithContext("url", resp.Url))
 			}
 		}
 		if len(resp.ThumbnailUrl) > 0 {
-			if err := downloadImage(ctx, resp.ThumbnailUrl, dir); err != nil {
-				return errs.Wrap(err, errs.WithContext("thumbnailUrl", resp.ThumbnailUrl))
+			if err := downloadImage(ctx, dl.Client(), resp.ThumbnailUrl, work, dl.maxSize); err != nil {
+				return StatusFailed, errs.Wrap(err, errs.WithContext("thumbnailUrl", resp.ThumbnailUrl))
 			}
 		}
+	}
+	if err := commitDir(work, dir); err != nil {
+		return StatusFailed, errs.Wrap(err)
+	}
+	return status, nil
+}
 
+// firstError function returns the first error in order of errList.
+// Errors caused only by cancelling other jobs are skipped unless ctx (parent context) itself is done.
+func firstError(ctx context.Context, errList []error, name func(i int) string) error {
+	var canceled error
+	for i, err := range errList {
+		if err == nil {
+			continue
+		}
+		if errors.Is(err, context.Canceled) && ctx.Err() == nil {
+			if canceled == nil {
+				canceled = errs.Wrap(err, errs.WithContext("date", name(i)))
+			}
+			continue
+		}
+		return errs.Wrap(err, errs.WithContext("date", name(i)))
 	}
+	return canceled
+}
 
+// makeBaseDir makes base directory for download if it is not found.
+func makeBaseDir(dir string) error {
+	if _, err := os.Stat(dir); err != nil { // dirextory is not found
+		if err := os.MkdirAll(dir, os.ModePerm); err != nil {
+			return errs.Wrap(err, errs.WithContext("dir", dir))
+		}
+	}
 	return nil
 }
 
-func saveMetadate(resp *apod.Response, path string) error {
+func saveMetadata(resp interface{}, path string) error {
 	file, err := os.Create(path)
 	if err != nil {
 		return errs.Wrap(err, errs.WithContext("path", path))
@@ -114,32 +243,32 @@ func saveMetadate(resp *apod.Response, path string) error {
 	return errs.Wrap(enc.Encode(resp))
 }
 
-func downloadImage(ctx context.Context, urlStr string, dir string) error {
+func downloadImage(ctx context.Context, cli *nasaapi.Client, urlStr string, dir string, maxSize int64) error {
 	u, err := fetch.URL(urlStr)
 	if err != nil {
 		return errs.Wrap(err, errs.WithContext("url", urlStr))
 	}
 	_, fname := path.Split(u.Path)
-	resp, err := fetch.New().Get(u, fetch.WithContext(ctx))
-	if err != nil {
+	if err := checkName(fname); err != nil {
 		return errs.Wrap(err, errs.WithContext("url", urlStr))
 	}
-	defer resp.Close()
+	return downloadFile(ctx, cli, u, filepath.Join(dir, fname), maxSize)
+}
 
-	path := filepath.Join(dir, fname)
-	file, err := os.Create(path)
-	if err != nil {
-		return errs.Wrap(err, errs.WithContext("path", path))
-	}
-	defer file.Close()
-	if _, err := io.CopyN(file, resp.Body(), maxDataSize); err != nil {
-		if !errors.Is(err, io.EOF) {
-			return errs.Wrap(err, errs.WithContext("path", path))
-		}
+// checkName function returns error if name received from server is not safe as a file or directory name in download directory.
+func checkName(name string) error {
+	if len(name) == 0 || name == "." || filepath.Base(name) != name || strings.Contains(name, "..") || strings.ContainsAny(name, `/\`) {
+		return errs.Wrap(ecode.ErrInvalidParameter, errs.WithContext("name", name))
 	}
 	return nil
 }
 
+func downloadFile(ctx context.Context, cli *nasaapi.Client, u *url.URL, path string, maxSize int64) error {
+	return cli.Retry(ctx, func() error {
+		return fetchFile(ctx, cli, u, path, maxSize)
+	})
+}
+
 /* MIT License
  *
  * Copyright 2023 Spiegel
@@ -0,0 +1,106 @@
+package download
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/goark/apod/ecode"
+	"github.com/goark/apod/internal/pool"
+	"github.com/goark/apod/nasaapi/earth"
+)
+
+func TestCheckName(t *testing.T) {
+	testCases := []struct {
+		name string
+		err  error
+	}{
+		{name: "as11-40-5874", err: nil},
+		{name: "epic_1b_20230101003633", err: nil},
+		{name: "foo.jpg", err: nil},
+		{name: "", err: ecode.ErrInvalidParameter},
+		{name: ".", err: ecode.ErrInvalidParameter},
+		{name: "..", err: ecode.ErrInvalidParameter},
+		{name: "../foo", err: ecode.ErrInvalidParameter},
+		{name: "foo/bar", err: ecode.ErrInvalidParameter},
+		{name: "/etc", err: ecode.ErrInvalidParameter},
+		{name: `..\foo`, err: ecode.ErrInvalidParameter},
+		{name: `foo\bar`, err: ecode.ErrInvalidParameter},
+	}
+	for _, tc := range testCases {
+		if err := checkName(tc.name); !errors.Is(err, tc.err) || (tc.err == nil && err != nil) {
+			t.Errorf("checkName(%v) is \"%v\", want \"%v\"", tc.name, err, tc.err)
+		}
+	}
+}
+
+func TestEarthLocationName(t *testing.T) {
+	testCases := []struct {
+		loc *earth.Location
+		err error
+	}{
+		{loc: &earth.Location{Name: "..foo", Lat: 1.5, Lon: 100.75}, err: ecode.ErrInvalidParameter},
+		{loc: &earth.Location{Name: "foo/bar", Lat: 1.5, Lon: 100.75}, err: ecode.ErrInvalidParameter},
+		{loc: nil, err: ecode.ErrInvalidParameter},
+	}
+	for _, tc := range testCases {
+		base := filepath.Join(t.TempDir(), "earth")
+		if err := NewEarth(earth.New(), []*earth.Location{tc.loc}, base, false).Do(context.Background()); !errors.Is(err, tc.err) {
+			t.Errorf("Do(%v) is \"%v\", want \"%v\"", tc.loc, err, tc.err)
+		}
+		if _, err := os.Stat(base); err == nil {
+			t.Errorf("%v is created, want no download", base)
+		}
+	}
+}
+
+func TestFirstError(t *testing.T) {
+	errFoo := errors.New("foo")
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	jobCtx, jobCancel := context.WithCancel(ctx)
+	errList := pool.Run(jobCtx, 2, 6, func(ctx context.Context, i int) error {
+		switch i {
+		case 0:
+			<-ctx.Done()
+			return ctx.Err()
+		case 1:
+			jobCancel()
+			return errFoo
+		default:
+			return nil
+		}
+	})
+	if err := firstError(ctx, errList, func(i int) string { return "" }); !errors.Is(err, errFoo) {
+		t.Errorf("firstError() is \"%v\", want \"%v\"", err, errFoo)
+	}
+	cancel()
+	if err := firstError(ctx, errList, func(i int) string { return "" }); !errors.Is(err, context.Canceled) {
+		t.Errorf("firstError() is \"%v\", want \"%v\"", err, context.Canceled)
+	}
+}
+
+/* MIT License
+ *
+ * Copyright 2023 Spiegel
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
@@ -0,0 +1,154 @@
+package download
+
+import (
+	"context"
+	"path/filepath"
+	"time"
+
+	"github.com/goark/apod/ecode"
+	"github.com/goark/apod/nasaapi"
+	"github.com/goark/apod/nasaapi/earth"
+	"github.com/goark/errs"
+)
+
+// Earth is configuration for downloading Earth imagery.
+type Earth struct {
+	*earth.Request
+	locations     []*earth.Location
+	baseDir       string
+	overwriteFlag bool
+	fileLimit
+}
+
+// EarthMetadata is metadata of downloaded Earth imagery.
+type EarthMetadata struct {
+	Location *earth.Location `json:"location"`
+	Asset    *earth.Asset    `json:"asset"`
+}
+
+// NewEarth returns new Earth instance.
+// Dim and Date of each location override ones of cfg.
+func NewEarth(cfg *earth.Request, locations []*earth.Location, baseDir string, overwriteFlag bool, opts ...FileOpts) *Earth {
+	if len(baseDir) == 0 {
+		baseDir = "."
+	}
+	dl := &Earth{
+		Request:       cfg,
+		locations:     locations,
+		baseDir:       baseDir,
+		overwriteFlag: overwriteFlag,
+	}
+	for _, opt := range opts {
+		opt(&dl.fileLimit)
+	}
+	return dl
+}
+
+// Do method is downloading Earth imagery from NASA API.
+// Images are stored in <base dir>/<date of asset>/<location name>/ directory with metadata.json file.
+func (dl *Earth) Do(ctx context.Context) error {
+	if dl == nil || dl.Request == nil {
+		return errs.Wrap(ecode.ErrNullPointer)
+	}
+	for _, loc := range dl.locations {
+		if err := checkName(loc.String()); err != nil {
+			return errs.Wrap(err)
+		}
+	}
+
+	// make directory
+	if err := makeBaseDir(dl.baseDir); err != nil {
+		return errs.Wrap(err)
+	}
+
+	for _, loc := range dl.locations {
+		req := *dl.Request
+		for _, opt := range loc.Opts() {
+			opt(&req)
+		}
+		// get metadata of Earth imagery from NASA API
+		assets, err := req.Assets(ctx)
+		if err != nil {
+			return errs.Wrap(err, errs.WithContext("location", loc.String()))
+		}
+		asset := closestAsset(assets, req.Date)
+		if asset == nil {
+			return errs.Wrap(ecode.ErrNotFound, errs.WithContext("location", loc.String()), errs.WithContext("date", req.Date))
+		}
+		req.Date = nasaapi.NewDate(asset.Date.Time)
+
+		// make directory
+		dir := filepath.Join(dl.baseDir, req.Date.String(), loc.String())
+		work, ok, err := prepareDir(dir, dl.overwriteFlag)
+		if err != nil {
+			return errs.Wrap(err)
+		} else if !ok {
+			continue
+		}
+
+		// output metadata.json file
+		if err := saveMetadata(&EarthMetadata{Location: loc, Asset: asset}, filepath.Join(work, "metadata.json")); err != nil {
+			return errs.Wrap(err)
+		}
+		// download image file
+		u, err := req.ImageryURL()
+		if err != nil {
+			return errs.Wrap(err)
+		}
+		if err := downloadFile(ctx, req.Client(), u, filepath.Join(work, "imagery.png"), dl.maxSize); err != nil {
+			return errs.Wrap(err, errs.WithContext("location", loc.String()))
+		}
+		if err := commitDir(work, dir); err != nil {
+			return errs.Wrap(err)
+		}
+	}
+	return nil
+}
+
+// closestAsset returns the asset closest to the date. If date is zero, returns the latest asset.
+func closestAsset(assets []*earth.Asset, date nasaapi.Date) *earth.Asset {
+	var closest *earth.Asset
+	var minDiff time.Duration
+	for _, a := range assets {
+		if a == nil || a.Date.IsZero() {
+			continue
+		}
+		if date.IsZero() {
+			if closest == nil || a.Date.After(closest.Date.Time) {
+				closest = a
+			}
+			continue
+		}
+		diff := a.Date.Sub(date.Time)
+		if diff < 0 {
+			diff = -diff
+		}
+		if closest == nil || diff < minDiff {
+			closest, minDiff = a, diff
+		}
+	}
+	return closest
+}
+
+/* MIT License
+ *
+ * Copyright 2023 Spiegel
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
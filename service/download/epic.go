@@ -0,0 +1,124 @@
+package download
+
+import (
+	"context"
+	"path/filepath"
+
+	"github.com/goark/apod/ecode"
+	"github.com/goark/apod/nasaapi"
+	"github.com/goark/apod/nasaapi/epic"
+	"github.com/goark/errs"
+)
+
+// EPIC is configuration for downloading EPIC images.
+type EPIC struct {
+	*epic.Request
+	dates         []nasaapi.Date
+	format        epic.Format
+	baseDir       string
+	overwriteFlag bool
+	fileLimit
+}
+
+// NewEPIC returns new EPIC instance. If dates is empty, most recent images are downloaded.
+func NewEPIC(cfg *epic.Request, dates []nasaapi.Date, format epic.Format, baseDir string, overwriteFlag bool, opts ...FileOpts) *EPIC {
+	if len(baseDir) == 0 {
+		baseDir = "."
+	}
+	dl := &EPIC{
+		Request:       cfg,
+		dates:         dates,
+		format:        format,
+		baseDir:       baseDir,
+		overwriteFlag: overwriteFlag,
+	}
+	for _, opt := range opts {
+		opt(&dl.fileLimit)
+	}
+	return dl
+}
+
+// Do method is downloading EPIC images from NASA API.
+// Images are stored in <base dir>/<date>/<image name>/ directory with metadata.json file.
+func (dl *EPIC) Do(ctx context.Context) error {
+	if dl == nil || dl.Request == nil {
+		return errs.Wrap(ecode.ErrNullPointer)
+	}
+	// get metadata of EPIC images from NASA API
+	var images []*epic.Image
+	if len(dl.dates) == 0 {
+		list, err := dl.Latest(ctx)
+		if err != nil {
+			return errs.Wrap(err)
+		}
+		images = list
+	}
+	for _, date := range dl.dates {
+		req := *dl.Request
+		req.Date = date
+		list, err := req.Images(ctx)
+		if err != nil {
+			return errs.Wrap(err)
+		}
+		images = append(images, list...)
+	}
+
+	// make directory
+	if err := makeBaseDir(dl.baseDir); err != nil {
+		return errs.Wrap(err)
+	}
+
+	for _, img := range images {
+		// make directory
+		if err := checkName(img.Image); err != nil {
+			return errs.Wrap(err, errs.WithContext("image", img.Image))
+		}
+		dir := filepath.Join(dl.baseDir, nasaapi.NewDate(img.Date.Time).String(), img.Image)
+		work, ok, err := prepareDir(dir, dl.overwriteFlag)
+		if err != nil {
+			return errs.Wrap(err)
+		} else if !ok {
+			continue
+		}
+
+		// output metadata.json file
+		if err := saveMetadata(img, filepath.Join(work, "metadata.json")); err != nil {
+			return errs.Wrap(err)
+		}
+		// download image file
+		u, err := dl.ImageURL(img, dl.format)
+		if err != nil {
+			return errs.Wrap(err)
+		}
+		if err := downloadImage(ctx, dl.Client(), u.String(), work, dl.maxSize); err != nil {
+			return errs.Wrap(err, errs.WithContext("image", img.Image))
+		}
+		if err := commitDir(work, dir); err != nil {
+			return errs.Wrap(err)
+		}
+	}
+	return nil
+}
+
+/* MIT License
+ *
+ * Copyright 2023 Spiegel
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
@@ -0,0 +1,141 @@
+package download
+
+import (
+	"context"
+	"path/filepath"
+
+	"github.com/goark/apod/ecode"
+	"github.com/goark/apod/nasaapi"
+	"github.com/goark/apod/nasaapi/images"
+	"github.com/goark/errs"
+)
+
+// Images is configuration for downloading media of NASA Image and Video Library.
+type Images struct {
+	*images.Request
+	nasaIDs       []string
+	size          string
+	baseDir       string
+	overwriteFlag bool
+	fileLimit
+}
+
+// NewImages returns new Images instance. If nasaIDs is empty, search results of cfg are downloaded.
+func NewImages(cfg *images.Request, nasaIDs []string, size string, baseDir string, overwriteFlag bool, opts ...FileOpts) *Images {
+	if len(baseDir) == 0 {
+		baseDir = "."
+	}
+	if len(size) == 0 {
+		size = "orig"
+	}
+	dl := &Images{
+		Request:       cfg,
+		nasaIDs:       nasaIDs,
+		size:          size,
+		baseDir:       baseDir,
+		overwriteFlag: overwriteFlag,
+	}
+	for _, opt := range opts {
+		opt(&dl.fileLimit)
+	}
+	return dl
+}
+
+// Do method is downloading media from NASA Image and Video Library.
+// Media files are stored in <base dir>/<date created>/<NASA ID>/ directory with metadata.json file.
+func (dl *Images) Do(ctx context.Context) error {
+	if dl == nil || dl.Request == nil {
+		return errs.Wrap(ecode.ErrNullPointer)
+	}
+	// search media
+	var items []*images.ItemData
+	if len(dl.nasaIDs) == 0 {
+		c, err := dl.Search(ctx)
+		if err != nil {
+			return errs.Wrap(err)
+		}
+		items = itemData(c)
+	}
+	for _, id := range dl.nasaIDs {
+		c, err := images.New(images.WithNasaID(id), images.WithBaseURL(dl.BaseURL()), images.WithClient(dl.Client())).Search(ctx)
+		if err != nil {
+			return errs.Wrap(err, errs.WithContext("nasa_id", id))
+		}
+		list := itemData(c)
+		if len(list) == 0 {
+			return errs.Wrap(ecode.ErrNotFound, errs.WithContext("nasa_id", id))
+		}
+		items = append(items, list...)
+	}
+
+	// make directory
+	if err := makeBaseDir(dl.baseDir); err != nil {
+		return errs.Wrap(err)
+	}
+
+	for _, item := range items {
+		// make directory
+		if err := checkName(item.NasaID); err != nil {
+			return errs.Wrap(err, errs.WithContext("nasa_id", item.NasaID))
+		}
+		dir := filepath.Join(dl.baseDir, nasaapi.NewDate(item.DateCreated).String(), item.NasaID)
+		work, ok, err := prepareDir(dir, dl.overwriteFlag)
+		if err != nil {
+			return errs.Wrap(err)
+		} else if !ok {
+			continue
+		}
+
+		// output metadata.json file
+		if err := saveMetadata(item, filepath.Join(work, "metadata.json")); err != nil {
+			return errs.Wrap(err)
+		}
+		// download media file
+		asset, err := dl.Asset(ctx, item.NasaID)
+		if err != nil {
+			return errs.Wrap(err)
+		}
+		if href := images.SelectAsset(asset.Hrefs(), dl.size); len(href) > 0 {
+			if err := downloadImage(ctx, dl.Client(), href, work, dl.maxSize); err != nil {
+				return errs.Wrap(err, errs.WithContext("nasa_id", item.NasaID))
+			}
+		}
+		if err := commitDir(work, dir); err != nil {
+			return errs.Wrap(err)
+		}
+	}
+	return nil
+}
+
+func itemData(c *images.Collection) []*images.ItemData {
+	var list []*images.ItemData
+	for _, item := range c.Items {
+		if item != nil && len(item.Data) > 0 && item.Data[0] != nil && len(item.Data[0].NasaID) > 0 {
+			list = append(list, item.Data[0])
+		}
+	}
+	return list
+}
+
+/* MIT License
+ *
+ * Copyright 2023 Spiegel
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
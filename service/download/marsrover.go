@@ -0,0 +1,102 @@
+package download
+
+import (
+	"context"
+	"path/filepath"
+	"strconv"
+
+	"github.com/goark/apod/ecode"
+	"github.com/goark/apod/nasaapi/marsrover"
+	"github.com/goark/errs"
+)
+
+// MarsRover is configuration for downloading Mars rover photos.
+type MarsRover struct {
+	*marsrover.Request
+	baseDir       string
+	overwriteFlag bool
+	fileLimit
+}
+
+// NewMarsRover returns new MarsRover instance.
+func NewMarsRover(cfg *marsrover.Request, baseDir string, overwriteFlag bool, opts ...FileOpts) *MarsRover {
+	if len(baseDir) == 0 {
+		baseDir = "."
+	}
+	dl := &MarsRover{
+		Request:       cfg,
+		baseDir:       baseDir,
+		overwriteFlag: overwriteFlag,
+	}
+	for _, opt := range opts {
+		opt(&dl.fileLimit)
+	}
+	return dl
+}
+
+// Do method is downloading Mars rover photos from NASA API.
+// Photos are stored in <base dir>/<earth date>/<photo id>/ directory with metadata.json file.
+func (dl *MarsRover) Do(ctx context.Context) error {
+	if dl == nil || dl.Request == nil {
+		return errs.Wrap(ecode.ErrNullPointer)
+	}
+	// get photo data from NASA API
+	photos, err := dl.Photos(ctx)
+	if err != nil {
+		return errs.Wrap(err)
+	}
+
+	// make directory
+	if err := makeBaseDir(dl.baseDir); err != nil {
+		return errs.Wrap(err)
+	}
+
+	for _, photo := range photos {
+		// make directory
+		dir := filepath.Join(dl.baseDir, photo.EarthDate.String(), strconv.Itoa(photo.ID))
+		work, ok, err := prepareDir(dir, dl.overwriteFlag)
+		if err != nil {
+			return errs.Wrap(err)
+		} else if !ok {
+			continue
+		}
+
+		// output metadata.json file
+		if err := saveMetadata(photo, filepath.Join(work, "metadata.json")); err != nil {
+			return errs.Wrap(err)
+		}
+		// download photo file
+		if len(photo.ImgSrc) > 0 {
+			if err := downloadImage(ctx, dl.Client(), photo.ImgSrc, work, dl.maxSize); err != nil {
+				return errs.Wrap(err, errs.WithContext("img_src", photo.ImgSrc))
+			}
+		}
+		if err := commitDir(work, dir); err != nil {
+			return errs.Wrap(err)
+		}
+	}
+	return nil
+}
+
+/* MIT License
+ *
+ * Copyright 2023 Spiegel
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
@@ -0,0 +1,64 @@
+package download
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/goark/apod/ecode"
+	"github.com/goark/apod/internal/testutil"
+	"github.com/goark/apod/nasaapi/marsrover"
+)
+
+func TestMarsRoverMaxSize(t *testing.T) {
+	var u *url.URL
+	u, cli := testutil.NewServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == marsrover.RoversPath+"/curiosity/photos" {
+			fmt.Fprintf(w, `{"photos":[{"id":1,"sol":1000,"camera":{"name":"FHAZ"},"img_src":"%s/img/1.jpg","earth_date":"2015-05-30"}]}`, u)
+			return
+		}
+		fmt.Fprint(w, strings.Repeat("x", 100))
+	})
+	cfg := marsrover.New(marsrover.WithSol(1000), marsrover.WithClient(cli))
+
+	testCases := []struct {
+		opts []FileOpts
+		err  error
+	}{
+		{opts: nil, err: nil},
+		{opts: []FileOpts{WithFileMaxSize(10)}, err: ecode.ErrFileTooLarge},
+	}
+	for _, tc := range testCases {
+		err := NewMarsRover(cfg, t.TempDir(), false, tc.opts...).Do(context.Background())
+		if !errors.Is(err, tc.err) || (tc.err == nil && err != nil) {
+			t.Errorf("Do() is \"%v\", want \"%v\"", err, tc.err)
+		}
+	}
+}
+
+/* MIT License
+ *
+ * Copyright 2023 Spiegel
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
@@ -0,0 +1,175 @@
+package download
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"net"
+	"strconv"
+	"text/tabwriter"
+
+	"github.com/goark/apod/ecode"
+	"github.com/goark/apod/nasaapi"
+	"github.com/goark/errs"
+)
+
+// Status is outcome of downloading APOD data of a date.
+type Status string
+
+const (
+	StatusDownloaded       Status = "downloaded"        // files are downloaded
+	StatusSkippedExisting  Status = "skipped-existing"  // directory of the date already exists
+	StatusSkippedCopyright Status = "skipped-copyright" // only metadata is saved because media is not public domain
+	StatusFailed           Status = "failed"            // download failed
+	StatusCanceled         Status = "canceled"          // download is not completed because of cancellation
+)
+
+// Outcome is result of downloading APOD data of a date.
+type Outcome struct {
+	Date   nasaapi.Date `json:"date"`
+	Status Status       `json:"status"`
+	Dir    string       `json:"dir,omitempty"`    // directory of the date
+	Reason string       `json:"reason,omitempty"` // short classification of error (e.g. "http-404", "file-too-large")
+	Error  string       `json:"error,omitempty"`  // error message
+}
+
+// Summary is number of dates for each Status.
+type Summary struct {
+	Total            int `json:"total"`
+	Downloaded       int `json:"downloaded"`
+	SkippedExisting  int `json:"skipped_existing"`
+	SkippedCopyright int `json:"skipped_copyright"`
+	Failed           int `json:"failed"`
+	Canceled         int `json:"canceled"`
+}
+
+// Report is result of download command.
+type Report struct {
+	Summary  Summary    `json:"summary"`
+	Outcomes []*Outcome `json:"dates"`
+}
+
+// newReport function returns new Report instance from outcomes in date order.
+func newReport(outcomes []*Outcome) *Report {
+	r := &Report{Outcomes: outcomes}
+	for _, o := range outcomes {
+		r.Summary.Total++
+		switch o.Status {
+		case StatusDownloaded:
+			r.Summary.Downloaded++
+		case StatusSkippedExisting:
+			r.Summary.SkippedExisting++
+		case StatusSkippedCopyright:
+			r.Summary.SkippedCopyright++
+		case StatusFailed:
+			r.Summary.Failed++
+		case StatusCanceled:
+			r.Summary.Canceled++
+		}
+	}
+	return r
+}
+
+// newOutcome function returns Outcome instance from result of downloadDate method.
+func newOutcome(date nasaapi.Date, dir string, status Status, err error) *Outcome {
+	o := &Outcome{Date: date, Status: status, Dir: dir}
+	if err != nil {
+		o.Status = StatusFailed
+		if errors.Is(err, context.Canceled) {
+			o.Status = StatusCanceled
+		}
+		o.Reason = reasonOf(err)
+		o.Error = err.Error()
+	}
+	return o
+}
+
+// reasonOf function returns short classification of err.
+func reasonOf(err error) string {
+	var apiErr *nasaapi.APIError
+	var netErr net.Error
+	switch {
+	case errors.Is(err, context.Canceled):
+		return "canceled"
+	case errors.Is(err, context.DeadlineExceeded):
+		return "timeout"
+	case errors.Is(err, ecode.ErrFileTooLarge):
+		return "file-too-large"
+	case errors.Is(err, ecode.ErrSizeMismatch), errors.Is(err, io.ErrUnexpectedEOF):
+		return "incomplete"
+	case errors.As(err, &apiErr):
+		return "http-" + strconv.Itoa(apiErr.StatusCode)
+	case errors.As(err, &netErr):
+		return "network"
+	case errors.Is(err, fs.ErrPermission), errors.Is(err, fs.ErrExist), errors.Is(err, fs.ErrNotExist):
+		return "filesystem"
+	}
+	return "error"
+}
+
+// Partial method returns true if some dates failed and the others succeeded (or skipped).
+func (r *Report) Partial() bool {
+	if r == nil {
+		return false
+	}
+	return r.Summary.Failed > 0 && r.Summary.Failed+r.Summary.Canceled < r.Summary.Total
+}
+
+// WriteTable method writes summary table of Report into w.
+func (r *Report) WriteTable(w io.Writer) error {
+	if r == nil {
+		return errs.Wrap(ecode.ErrNullPointer)
+	}
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(tw, "DATE\tSTATUS\tDETAIL")
+	for _, o := range r.Outcomes {
+		detail := o.Dir
+		if len(o.Reason) > 0 {
+			detail = o.Reason + ": " + o.Error
+		}
+		fmt.Fprintf(tw, "%v\t%v\t%v\n", o.Date, o.Status, detail)
+	}
+	if err := tw.Flush(); err != nil {
+		return errs.Wrap(err)
+	}
+	s := r.Summary
+	_, err := fmt.Fprintf(w, "total %d: downloaded %d, skipped-existing %d, skipped-copyright %d, failed %d, canceled %d\n",
+		s.Total, s.Downloaded, s.SkippedExisting, s.SkippedCopyright, s.Failed, s.Canceled)
+	return errs.Wrap(err)
+}
+
+// EncodeJSON method writes Report into w with JSON format.
+func (r *Report) EncodeJSON(w io.Writer) error {
+	if r == nil {
+		return errs.Wrap(ecode.ErrNullPointer)
+	}
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "\t")
+	return errs.Wrap(enc.Encode(r))
+}
+
+/* MIT License
+ *
+ * Copyright 2023 Spiegel
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
@@ -0,0 +1,204 @@
+package download
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/goark/apod/ecode"
+	"github.com/goark/apod/internal/testutil"
+	"github.com/goark/apod/nasaapi"
+	"github.com/goark/apod/nasaapi/apod"
+	"github.com/goark/errs"
+)
+
+func TestReasonOf(t *testing.T) {
+	testCases := []struct {
+		err    error
+		reason string
+	}{
+		{err: errs.Wrap(context.Canceled), reason: "canceled"},
+		{err: errs.Wrap(context.DeadlineExceeded), reason: "timeout"},
+		{err: errs.Wrap(ecode.ErrFileTooLarge), reason: "file-too-large"},
+		{err: errs.Wrap(ecode.ErrSizeMismatch), reason: "incomplete"},
+		{err: errs.Wrap(&nasaapi.APIError{StatusCode: http.StatusNotFound}), reason: "http-404"},
+		{err: errors.New("foo"), reason: "error"},
+	}
+	for _, tc := range testCases {
+		if reason := reasonOf(tc.err); reason != tc.reason {
+			t.Errorf("reasonOf(%v) is \"%v\", want \"%v\"", tc.err, reason, tc.reason)
+		}
+	}
+}
+
+func TestReportPartial(t *testing.T) {
+	testCases := []struct {
+		statusList []Status
+		partial    bool
+	}{
+		{statusList: []Status{StatusDownloaded, StatusSkippedExisting, StatusSkippedCopyright}, partial: false},
+		{statusList: []Status{StatusDownloaded, StatusFailed}, partial: true},
+		{statusList: []Status{StatusSkippedExisting, StatusFailed}, partial: true},
+		{statusList: []Status{StatusFailed, StatusFailed}, partial: false},
+		{statusList: []Status{StatusFailed, StatusCanceled}, partial: false},
+		{statusList: nil, partial: false},
+	}
+	for _, tc := range testCases {
+		var outcomes []*Outcome
+		for _, status := range tc.statusList {
+			outcomes = append(outcomes, &Outcome{Status: status})
+		}
+		if partial := newReport(outcomes).Partial(); partial != tc.partial {
+			t.Errorf("Report.Partial(%v) is \"%v\", want \"%v\"", tc.statusList, partial, tc.partial)
+		}
+	}
+}
+
+func TestDoKeepGoing(t *testing.T) {
+	dates := []string{"2023-01-01", "2023-01-02", "2023-01-03"}
+	var u *url.URL
+	u, cli := testutil.NewServer(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == apod.APIPath:
+			var list []string
+			for _, date := range dates {
+				copyright := ""
+				if date == "2023-01-03" {
+					copyright = `"copyright":"foo",`
+				}
+				list = append(list, fmt.Sprintf(`{"date":"%s",%s"media_type":"image","title":"%s","url":"%s/img/%s.jpg"}`, date, copyright, date, u, date))
+			}
+			fmt.Fprintf(w, "[%s]", strings.Join(list, ","))
+		case r.URL.Path == "/img/2023-01-02.jpg":
+			http.NotFound(w, r)
+		default:
+			fmt.Fprint(w, "image")
+		}
+	}, nasaapi.WithRetryPolicy(nasaapi.RetryPolicy{MaxAttempts: 1}))
+	cfg := apod.New(
+		apod.WithStartDate(testutil.DateFromMust(t, dates[0])),
+		apod.WithEndDate(testutil.DateFromMust(t, dates[len(dates)-1])),
+		apod.WithClient(cli),
+	)
+	base := t.TempDir()
+
+	testCases := []struct {
+		keepGoing  bool
+		statusList []Status
+		partial    bool
+	}{
+		{keepGoing: false, statusList: []Status{StatusDownloaded, StatusFailed, StatusCanceled}, partial: false},
+		{keepGoing: true, statusList: []Status{StatusSkippedExisting, StatusFailed, StatusSkippedCopyright}, partial: true},
+	}
+	for _, tc := range testCases {
+		dl := New(cfg, base, false, false, WithKeepGoing(tc.keepGoing))
+		err := dl.Do(context.Background())
+		if err == nil || errors.Is(err, ecode.ErrPartialDownload) != tc.partial {
+			t.Errorf("Do(keep-going: %v) is \"%v\", partial error: %v", tc.keepGoing, err, tc.partial)
+		}
+		report := dl.Report()
+		if report == nil || len(report.Outcomes) != len(tc.statusList) {
+			t.Fatalf("Report() is \"%+v\", want %d outcomes", report, len(tc.statusList))
+		}
+		for i, o := range report.Outcomes {
+			if o.Status != tc.statusList[i] {
+				t.Errorf("status of %v is \"%v\", want \"%v\"", o.Date, o.Status, tc.statusList[i])
+			}
+			if o.Dir != filepath.Join(base, dates[i]) {
+				t.Errorf("dir of %v is \"%v\", want \"%v\"", o.Date, o.Dir, filepath.Join(base, dates[i]))
+			}
+		}
+	}
+}
+
+func TestDoKeepGoingChunkError(t *testing.T) {
+	var u *url.URL
+	u, cli := testutil.NewServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != apod.APIPath {
+			fmt.Fprint(w, "image")
+			return
+		}
+		start, _ := nasaapi.DateFrom(r.URL.Query().Get("start_date"))
+		end, _ := nasaapi.DateFrom(r.URL.Query().Get("end_date"))
+		if start.String() == "2023-01-03" {
+			http.Error(w, `{"code":400,"msg":"bad request"}`, http.StatusBadRequest)
+			return
+		}
+		var list []string
+		for date := start; !date.After(end.Time); date = nasaapi.NewDate(date.AddDate(0, 0, 1)) {
+			list = append(list, fmt.Sprintf(`{"date":"%s","media_type":"image","title":"%s","url":"%s/img/%s.jpg"}`, date, date, u, date))
+		}
+		fmt.Fprintf(w, "[%s]", strings.Join(list, ","))
+	}, nasaapi.WithRetryPolicy(nasaapi.RetryPolicy{MaxAttempts: 1}))
+	cfg := apod.New(
+		apod.WithStartDate(testutil.DateFromMust(t, "2023-01-01")),
+		apod.WithEndDate(testutil.DateFromMust(t, "2023-01-04")),
+		apod.WithChunkDays(2),
+		apod.WithClient(cli),
+	)
+
+	// without keep-going mode, failed chunk stops downloading
+	dl := New(cfg, t.TempDir(), false, false)
+	var chunkErr *apod.ChunkError
+	if err := dl.Do(context.Background()); !errors.As(err, &chunkErr) {
+		t.Errorf("Do() is \"%v\", want ChunkError", err)
+	}
+	if dl.Report() != nil {
+		t.Errorf("Report() is \"%+v\", want nil", dl.Report())
+	}
+
+	// with keep-going mode, dates of failed chunk are reported as failed
+	dl = New(cfg, t.TempDir(), false, false, WithKeepGoing(true))
+	if err := dl.Do(context.Background()); !errors.Is(err, ecode.ErrPartialDownload) {
+		t.Errorf("Do() is \"%v\", want \"%v\"", err, ecode.ErrPartialDownload)
+	}
+	want := []struct {
+		date   string
+		status Status
+	}{
+		{date: "2023-01-01", status: StatusDownloaded},
+		{date: "2023-01-02", status: StatusDownloaded},
+		{date: "2023-01-03", status: StatusFailed},
+		{date: "2023-01-04", status: StatusFailed},
+	}
+	report := dl.Report()
+	if report == nil || len(report.Outcomes) != len(want) {
+		t.Fatalf("Report() is \"%+v\", want %d outcomes", report, len(want))
+	}
+	for i, o := range report.Outcomes {
+		if o.Date.String() != want[i].date || o.Status != want[i].status {
+			t.Errorf("outcome is (%v, %v), want (%v, %v)", o.Date, o.Status, want[i].date, want[i].status)
+		}
+	}
+	if report.Outcomes[2].Reason != "http-400" {
+		t.Errorf("reason is \"%v\", want \"http-400\"", report.Outcomes[2].Reason)
+	}
+}
+
+/* MIT License
+ *
+ * Copyright 2023 Spiegel
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
@@ -0,0 +1,218 @@
+package download
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/goark/apod/ecode"
+	"github.com/goark/apod/nasaapi"
+	"github.com/goark/errs"
+)
+
+const (
+	partExt     = ".part"      // extension of partial file
+	partInfoExt = ".part.json" // extension of validator file for partial file
+)
+
+// partInfo is validator of partial file, for resuming download with Range/If-Range headers.
+type partInfo struct {
+	URL          string `json:"url"`
+	ETag         string `json:"etag,omitempty"`
+	LastModified string `json:"last_modified,omitempty"`
+}
+
+// validator method returns value of If-Range header. Weak ETag cannot be used for If-Range.
+func (pi *partInfo) validator() string {
+	if pi == nil {
+		return ""
+	}
+	if len(pi.ETag) > 0 && !strings.HasPrefix(pi.ETag, "W/") {
+		return pi.ETag
+	}
+	return pi.LastModified
+}
+
+// fetchFile function downloads file from URL into path.
+// Data is written into <path>.part file, and the file is renamed to path when complete.
+// If <path>.part file remains (e.g. interrupted download), downloading is resumed by Range request
+// validated with ETag or Last-Modified (If-Range header). If path already exists, downloading is skipped.
+// If size of the file exceeds maxSize (or maxDataSize if maxSize is not positive), returns ecode.ErrFileTooLarge error.
+// If downloaded size does not match Content-Length header, returns ecode.ErrSizeMismatch error (partial file is kept to resume).
+func fetchFile(ctx context.Context, cli *nasaapi.Client, u *url.URL, path string, maxSize int64) error {
+	if _, err := os.Stat(path); err == nil {
+		return nil // completed by previous download
+	}
+	if maxSize <= 0 {
+		maxSize = maxDataSize
+	}
+	partPath, infoPath := path+partExt, path+partInfoExt
+
+	// request (with Range header if resumable)
+	var offset int64
+	var opts []nasaapi.RequestOpts
+	if info := loadPartInfo(infoPath); info != nil && info.URL == partURL(u) && len(info.validator()) > 0 {
+		if stat, err := os.Stat(partPath); err == nil && stat.Size() > 0 {
+			offset = stat.Size()
+			opts = append(opts,
+				nasaapi.WithRequestHeader("Range", fmt.Sprintf("bytes=%d-", offset)),
+				nasaapi.WithRequestHeader("If-Range", info.validator()),
+			)
+		}
+	}
+	resp, err := cli.GetOnce(ctx, u, opts...)
+	if err != nil {
+		var apiErr *nasaapi.APIError
+		if offset > 0 && errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusRequestedRangeNotSatisfiable {
+			// partial file is broken: restart from zero
+			removePartFiles(path)
+			return fetchFile(ctx, cli, u, path, maxSize)
+		}
+		return errs.Wrap(err, errs.WithContext("url", u.String()))
+	}
+	defer resp.Body.Close()
+
+	// open partial file
+	flag := os.O_CREATE | os.O_WRONLY | os.O_TRUNC
+	if offset > 0 {
+		if start, _ := contentRange(resp.Header.Get("Content-Range")); resp.StatusCode == http.StatusPartialContent && start == offset {
+			flag = os.O_WRONLY | os.O_APPEND
+		} else {
+			offset = 0 // server does not support Range request, or file is modified
+		}
+	}
+	// check size of the file before downloading
+	size := int64(-1)
+	if resp.ContentLength >= 0 {
+		size = offset + resp.ContentLength
+	}
+	if _, total := contentRange(resp.Header.Get("Content-Range")); offset > 0 && total >= 0 {
+		size = total
+	}
+	if size > maxSize {
+		removePartFiles(path)
+		return errs.Wrap(ecode.ErrFileTooLarge, errs.WithContext("url", u.String()), errs.WithContext("size", size), errs.WithContext("max_size", maxSize))
+	}
+	if offset == 0 {
+		if err := savePartInfo(infoPath, &partInfo{URL: partURL(u), ETag: resp.Header.Get("ETag"), LastModified: resp.Header.Get("Last-Modified")}); err != nil {
+			return errs.Wrap(err)
+		}
+	}
+	file, err := os.OpenFile(partPath, flag, 0644)
+	if err != nil {
+		return errs.Wrap(err, errs.WithContext("path", partPath))
+	}
+	// read one more byte than the limit to detect oversized file
+	written, err := io.CopyN(file, resp.Body, maxSize-offset+1)
+	if err != nil && !errors.Is(err, io.EOF) {
+		file.Close()
+		return errs.Wrap(err, errs.WithContext("path", partPath), errs.WithContext("offset", offset))
+	}
+	if err := file.Close(); err != nil {
+		return errs.Wrap(err, errs.WithContext("path", partPath))
+	}
+	if offset+written > maxSize {
+		removePartFiles(path)
+		return errs.Wrap(ecode.ErrFileTooLarge, errs.WithContext("url", u.String()), errs.WithContext("max_size", maxSize))
+	}
+	if resp.ContentLength >= 0 && written != resp.ContentLength {
+		// truncated response is transient failure (retried and resumed)
+		return errs.Wrap(ecode.ErrSizeMismatch, errs.WithCause(io.ErrUnexpectedEOF), errs.WithContext("url", u.String()), errs.WithContext("content_length", resp.ContentLength), errs.WithContext("written", written))
+	}
+
+	// complete
+	if err := os.Rename(partPath, path); err != nil {
+		return errs.Wrap(err, errs.WithContext("path", path))
+	}
+	_ = os.Remove(infoPath)
+	return nil
+}
+
+// contentRange function returns first byte position and complete length of Content-Range header ("bytes 100-199/200").
+// Returns -1 if invalid or unknown ("*").
+func contentRange(s string) (int64, int64) {
+	var start, end int64
+	var size string
+	if n, err := fmt.Sscanf(s, "bytes %d-%d/%s", &start, &end, &size); err != nil || n != 3 {
+		return -1, -1
+	}
+	total, err := strconv.ParseInt(size, 10, 64)
+	if err != nil {
+		return start, -1
+	}
+	return start, total
+}
+
+// partURL function returns URL saved in validator file. API key is removed not to save it in download directory.
+func partURL(u *url.URL) string {
+	pu := *u
+	q := pu.Query()
+	if !q.Has("api_key") {
+		return pu.String()
+	}
+	q.Del("api_key")
+	pu.RawQuery = q.Encode()
+	return pu.String()
+}
+
+func loadPartInfo(path string) *partInfo {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil
+	}
+	var info partInfo
+	if err := json.Unmarshal(b, &info); err != nil {
+		return nil
+	}
+	return &info
+}
+
+func savePartInfo(path string, info *partInfo) error {
+	b, err := json.Marshal(info)
+	if err != nil {
+		return errs.Wrap(err)
+	}
+	return errs.Wrap(os.WriteFile(path, b, 0644), errs.WithContext("path", path))
+}
+
+func removePartFiles(path string) {
+	_ = os.Remove(path + partExt)
+	_ = os.Remove(path + partInfoExt)
+}
+
+// hasPartFiles function reports whether dir has partial files of interrupted download.
+func hasPartFiles(dir string) bool {
+	list, err := filepath.Glob(filepath.Join(dir, "*"+partExt))
+	return err == nil && len(list) > 0
+}
+
+/* MIT License
+ *
+ * Copyright 2023 Spiegel
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
@@ -0,0 +1,198 @@
+package download
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/goark/apod/ecode"
+	"github.com/goark/apod/nasaapi"
+)
+
+func TestFetchFile(t *testing.T) {
+	content := bytes.Repeat([]byte("0123456789"), 1000)
+	var ranges []string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ranges = append(ranges, r.Header.Get("Range"))
+		switch r.URL.Path {
+		case "/etag.jpg":
+			w.Header().Set("ETag", `"v1"`)
+			http.ServeContent(w, r, "etag.jpg", time.Time{}, bytes.NewReader(content))
+		default: // no Range support
+			_, _ = w.Write(content)
+		}
+	}))
+	defer ts.Close()
+	cli := nasaapi.NewClient(nasaapi.WithKeyLimiter(nil))
+
+	testCases := []struct {
+		path  string
+		part  int
+		info  *partInfo
+		rng   string
+		parts bool
+	}{
+		{path: "/etag.jpg", part: 0, rng: ""},
+		{path: "/etag.jpg", part: 4000, info: &partInfo{ETag: `"v1"`}, rng: "bytes=4000-"},
+		{path: "/etag.jpg", part: 4000, info: &partInfo{ETag: `"v0"`}, rng: "bytes=4000-"}, // modified: full content is returned
+		{path: "/etag.jpg", part: 4000, info: &partInfo{ETag: `W/"v1"`}, rng: ""},          // weak ETag is not used
+		{path: "/etag.jpg", part: 4000, rng: ""},                                           // no validator
+		{path: "/etag.jpg?api_key=foo", part: 4000, info: &partInfo{ETag: `"v1"`}, rng: "bytes=4000-"},
+		{path: "/plain.jpg", part: 4000, info: &partInfo{LastModified: "Mon, 02 Jan 2006 15:04:05 GMT"}, rng: "bytes=4000-"},
+	}
+
+	for i, tc := range testCases {
+		dir := t.TempDir()
+		path := filepath.Join(dir, "image.jpg")
+		u, _ := url.Parse(ts.URL + tc.path)
+		if tc.part > 0 {
+			if err := os.WriteFile(path+partExt, content[:tc.part], 0644); err != nil {
+				t.Fatal(err)
+			}
+			if !hasPartFiles(dir) {
+				t.Errorf("hasPartFiles() [%d] is false, want true", i)
+			}
+		}
+		if tc.info != nil {
+			tc.info.URL = partURL(u)
+			if err := savePartInfo(path+partInfoExt, tc.info); err != nil {
+				t.Fatal(err)
+			}
+		}
+		ranges = nil
+		if err := fetchFile(context.Background(), cli, u, path, 0); err != nil {
+			t.Errorf("fetchFile() [%d] is \"%v\", want nil", i, err)
+			continue
+		}
+		if got := strings.Join(ranges, ","); got != tc.rng {
+			t.Errorf("Range header [%d] is \"%v\", want \"%v\"", i, got, tc.rng)
+		}
+		if b, err := os.ReadFile(path); err != nil || !bytes.Equal(b, content) {
+			t.Errorf("downloaded file [%d] is %d bytes (%v), want %d bytes", i, len(b), err, len(content))
+		}
+		if hasPartFiles(dir) {
+			t.Errorf("hasPartFiles() [%d] is true, want false", i)
+		}
+		if _, err := os.Stat(path + partInfoExt); err == nil {
+			t.Errorf("%v [%d] remains", path+partInfoExt, i)
+		}
+	}
+}
+
+func TestFetchFileSize(t *testing.T) {
+	content := bytes.Repeat([]byte("0123456789"), 100)
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/chunked.jpg": // no Content-Length
+			w.(http.Flusher).Flush()
+			_, _ = w.Write(content)
+		case "/truncated.jpg":
+			w.Header().Set("Content-Length", "2000")
+			_, _ = w.Write(content)
+		default:
+			_, _ = w.Write(content)
+		}
+	}))
+	defer ts.Close()
+	cli := nasaapi.NewClient(nasaapi.WithKeyLimiter(nil))
+
+	testCases := []struct {
+		path    string
+		maxSize int64
+		err     error
+		part    bool
+	}{
+		{path: "/plain.jpg", maxSize: 1000},
+		{path: "/plain.jpg", maxSize: 999, err: ecode.ErrFileTooLarge},
+		{path: "/chunked.jpg", maxSize: 1000},
+		{path: "/chunked.jpg", maxSize: 999, err: ecode.ErrFileTooLarge},
+		{path: "/truncated.jpg", maxSize: 0, err: io.ErrUnexpectedEOF, part: true},
+	}
+
+	for i, tc := range testCases {
+		dir := t.TempDir()
+		path := filepath.Join(dir, "image.jpg")
+		u, _ := url.Parse(ts.URL + tc.path)
+		err := fetchFile(context.Background(), cli, u, path, tc.maxSize)
+		if !errors.Is(err, tc.err) {
+			t.Errorf("fetchFile() [%d] is \"%v\", want \"%v\"", i, err, tc.err)
+			continue
+		}
+		if _, statErr := os.Stat(path); (statErr == nil) != (err == nil) {
+			t.Errorf("downloaded file [%d] exists: %v, want %v", i, statErr == nil, err == nil)
+		}
+		if hasPartFiles(dir) != tc.part {
+			t.Errorf("hasPartFiles() [%d] is %v, want %v", i, !tc.part, tc.part)
+		}
+	}
+}
+
+func TestPartURL(t *testing.T) {
+	testCases := []struct {
+		s    string
+		want string
+	}{
+		{s: "https://example.com/image.jpg", want: "https://example.com/image.jpg"},
+		{s: "https://epic.gsfc.nasa.gov/archive/natural/2019/05/30/png/epic_1b_20190530011359.png?api_key=foo", want: "https://epic.gsfc.nasa.gov/archive/natural/2019/05/30/png/epic_1b_20190530011359.png"},
+		{s: "https://api.nasa.gov/planetary/earth/imagery?api_key=foo&lat=1.5&lon=100.75", want: "https://api.nasa.gov/planetary/earth/imagery?lat=1.5&lon=100.75"},
+	}
+	for _, tc := range testCases {
+		u, _ := url.Parse(tc.s)
+		if got := partURL(u); got != tc.want {
+			t.Errorf("partURL(%v) is \"%v\", want \"%v\"", tc.s, got, tc.want)
+		}
+		if u.String() != tc.s {
+			t.Errorf("partURL(%v) modifies URL to \"%v\"", tc.s, u)
+		}
+	}
+}
+
+func TestContentRange(t *testing.T) {
+	testCases := []struct {
+		s     string
+		start int64
+		total int64
+	}{
+		{s: "bytes 100-199/200", start: 100, total: 200},
+		{s: "bytes 0-99/*", start: 0, total: -1},
+		{s: "bytes */200", start: -1, total: -1},
+		{s: "", start: -1, total: -1},
+	}
+	for _, tc := range testCases {
+		if start, total := contentRange(tc.s); start != tc.start || total != tc.total {
+			t.Errorf("contentRange(%q) is (%v, %v), want (%v, %v)", tc.s, start, total, tc.start, tc.total)
+		}
+	}
+}
+
+/* MIT License
+ *
+ * Copyright 2023 Spiegel
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
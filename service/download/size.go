@@ -0,0 +1,92 @@
+package download
+
+import (
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/goark/apod/ecode"
+	"github.com/goark/errs"
+)
+
+var sizeUnits = []struct {
+	suffix string
+	size   int64
+}{
+	{suffix: "KIB", size: 1 << 10},
+	{suffix: "MIB", size: 1 << 20},
+	{suffix: "GIB", size: 1 << 30},
+	{suffix: "TIB", size: 1 << 40},
+	{suffix: "KB", size: 1 << 10},
+	{suffix: "MB", size: 1 << 20},
+	{suffix: "GB", size: 1 << 30},
+	{suffix: "TB", size: 1 << 40},
+	{suffix: "K", size: 1 << 10},
+	{suffix: "M", size: 1 << 20},
+	{suffix: "G", size: 1 << 30},
+	{suffix: "T", size: 1 << 40},
+	{suffix: "B", size: 1},
+}
+
+// fileLimit is limit of downloaded files for EPIC, MarsRover, Images and Earth downloaders.
+type fileLimit struct {
+	maxSize int64
+}
+
+// FileOpts is option function type for EPIC, MarsRover, Images and Earth downloaders.
+type FileOpts func(*fileLimit)
+
+// WithFileMaxSize returns function for setting maximum size of a downloaded file in bytes (not positive is 1GB).
+func WithFileMaxSize(size int64) FileOpts {
+	return func(l *fileLimit) {
+		if l != nil {
+			l.maxSize = size
+		}
+	}
+}
+
+// ParseSize function parses size of file (e.g. "1048576", "500MB", "1.5GiB"). Units are in powers of 1024.
+func ParseSize(s string) (int64, error) {
+	str := strings.ToUpper(strings.TrimSpace(s))
+	unit := int64(1)
+	for _, u := range sizeUnits {
+		if strings.HasSuffix(str, u.suffix) {
+			str, unit = strings.TrimSpace(strings.TrimSuffix(str, u.suffix)), u.size
+			break
+		}
+	}
+	if n, err := strconv.ParseInt(str, 10, 64); err == nil && n > 0 {
+		if n > math.MaxInt64/unit {
+			return 0, errs.Wrap(ecode.ErrInvalidParameter, errs.WithContext("size", s))
+		}
+		return n * unit, nil
+	}
+	f, err := strconv.ParseFloat(str, 64)
+	if err != nil || f <= 0 || f*float64(unit) >= float64(1<<62) {
+		return 0, errs.Wrap(ecode.ErrInvalidParameter, errs.WithContext("size", s))
+	}
+	return int64(f * float64(unit)), nil
+}
+
+/* MIT License
+ *
+ * Copyright 2023 Spiegel
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
@@ -0,0 +1,63 @@
+package download
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/goark/apod/ecode"
+)
+
+func TestParseSize(t *testing.T) {
+	testCases := []struct {
+		s    string
+		size int64
+		err  error
+	}{
+		{s: "1048576", size: 1 << 20},
+		{s: "500MB", size: 500 << 20},
+		{s: "1gb", size: 1 << 30},
+		{s: "1.5GiB", size: 3 << 29},
+		{s: "64 K", size: 64 << 10},
+		{s: "10B", size: 10},
+		{s: "", err: ecode.ErrInvalidParameter},
+		{s: "0", err: ecode.ErrInvalidParameter},
+		{s: "-1MB", err: ecode.ErrInvalidParameter},
+		{s: "foo", err: ecode.ErrInvalidParameter},
+		{s: "8388607TB", size: 8388607 << 40},
+		{s: "8388608TB", err: ecode.ErrInvalidParameter},
+		{s: "9999999TB", err: ecode.ErrInvalidParameter},
+		{s: "99999999999999999999", err: ecode.ErrInvalidParameter},
+	}
+
+	for _, tc := range testCases {
+		size, err := ParseSize(tc.s)
+		if !errors.Is(err, tc.err) {
+			t.Errorf("ParseSize(%q) error is \"%v\", want \"%v\"", tc.s, err, tc.err)
+		} else if size != tc.size {
+			t.Errorf("ParseSize(%q) is %v, want %v", tc.s, size, tc.size)
+		}
+	}
+}
+
+/* MIT License
+ *
+ * Copyright 2023 Spiegel
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
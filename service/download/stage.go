@@ -0,0 +1,93 @@
+package download
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/goark/errs"
+)
+
+const (
+	stagingSuffix = ".staging" // suffix of staging directory
+	oldSuffix     = ".old"     // suffix of directory replaced by staging directory
+)
+
+// siblingDir function returns hidden sibling directory of dir ("<parent>/.<name><suffix>").
+func siblingDir(dir, suffix string) string {
+	parent, name := filepath.Split(filepath.Clean(dir))
+	return filepath.Join(parent, "."+name+suffix)
+}
+
+// prepareDir function makes working directory for downloading files into dir, and returns its path.
+// Files are downloaded into staging sibling directory, and swapped in by commitDir function
+// only after all files are downloaded, so the existing dir is left intact on failure.
+// If dir already exists and overwrite is false, returns false (skip)
+// unless dir has partial files of interrupted download (resumed in place).
+// Staging directory remaining from interrupted download is reused only if it has partial files.
+func prepareDir(dir string, overwrite bool) (string, bool, error) {
+	if _, err := os.Stat(dir); err == nil && !overwrite {
+		if hasPartFiles(dir) {
+			return dir, true, nil
+		}
+		return "", false, nil
+	}
+	staging := siblingDir(dir, stagingSuffix)
+	if _, err := os.Stat(staging); err == nil && !hasPartFiles(staging) {
+		if err := os.RemoveAll(staging); err != nil {
+			return "", false, errs.Wrap(err, errs.WithContext("dir", staging))
+		}
+	}
+	if err := os.MkdirAll(staging, os.ModePerm); err != nil {
+		return "", false, errs.Wrap(err, errs.WithContext("dir", staging))
+	}
+	return staging, true, nil
+}
+
+// commitDir function replaces dir by working directory made by prepareDir function.
+// Existing dir is moved aside and removed only after working directory is renamed into place
+// (restored if renaming fails).
+func commitDir(work, dir string) error {
+	if filepath.Clean(work) == filepath.Clean(dir) {
+		return nil
+	}
+	if _, err := os.Stat(dir); err != nil {
+		return errs.Wrap(os.Rename(work, dir), errs.WithContext("dir", dir))
+	}
+	old := siblingDir(dir, oldSuffix)
+	if err := os.RemoveAll(old); err != nil {
+		return errs.Wrap(err, errs.WithContext("dir", old))
+	}
+	if err := os.Rename(dir, old); err != nil {
+		return errs.Wrap(err, errs.WithContext("dir", dir))
+	}
+	if err := os.Rename(work, dir); err != nil {
+		if rerr := os.Rename(old, dir); rerr != nil {
+			return errs.Wrap(err, errs.WithContext("dir", dir), errs.WithContext("restore_error", rerr.Error()))
+		}
+		return errs.Wrap(err, errs.WithContext("dir", dir))
+	}
+	return errs.Wrap(os.RemoveAll(old), errs.WithContext("dir", old))
+}
+
+/* MIT License
+ *
+ * Copyright 2023 Spiegel
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
@@ -0,0 +1,95 @@
+package download
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestPrepareDir(t *testing.T) {
+	base := t.TempDir()
+	dir := filepath.Join(base, "2023-01-01")
+	staging := filepath.Join(base, ".2023-01-01.staging")
+
+	// new directory is staged, and renamed into place by commitDir
+	work, ok, err := prepareDir(dir, false)
+	if err != nil || !ok || work != staging {
+		t.Fatalf("prepareDir() is (%v, %v, %v), want (%v, true, nil)", work, ok, err, staging)
+	}
+	writeFile(t, filepath.Join(work, "old.jpg"))
+	if err := commitDir(work, dir); err != nil {
+		t.Fatalf("commitDir() is \"%v\", want nil", err)
+	}
+	checkFiles(t, base, ".2023-01-01.staging", false)
+	checkFiles(t, dir, "old.jpg", true)
+
+	// existing directory is skipped without overwrite
+	if _, ok, err := prepareDir(dir, false); err != nil || ok {
+		t.Errorf("prepareDir() is (%v, %v), want (false, nil)", ok, err)
+	}
+
+	// existing directory is left intact if download fails
+	work, ok, err = prepareDir(dir, true)
+	if err != nil || !ok || work != staging {
+		t.Fatalf("prepareDir() is (%v, %v, %v), want (%v, true, nil)", work, ok, err, staging)
+	}
+	writeFile(t, filepath.Join(work, "new.jpg"+partExt))
+	checkFiles(t, dir, "old.jpg", true)
+
+	// staging directory with partial files is reused
+	work, _, _ = prepareDir(dir, true)
+	checkFiles(t, work, "new.jpg"+partExt, true)
+	if err := os.Rename(filepath.Join(work, "new.jpg"+partExt), filepath.Join(work, "new.jpg")); err != nil {
+		t.Fatal(err)
+	}
+	if err := commitDir(work, dir); err != nil {
+		t.Fatalf("commitDir() is \"%v\", want nil", err)
+	}
+	checkFiles(t, dir, "old.jpg", false)
+	checkFiles(t, dir, "new.jpg", true)
+	checkFiles(t, base, ".2023-01-01.staging", false)
+	checkFiles(t, base, ".2023-01-01.old", false)
+
+	// staging directory without partial files is cleaned
+	work, _, _ = prepareDir(dir, true)
+	writeFile(t, filepath.Join(work, "stale.jpg"))
+	work, _, _ = prepareDir(dir, true)
+	checkFiles(t, work, "stale.jpg", false)
+}
+
+func writeFile(t *testing.T, path string) {
+	t.Helper()
+	if err := os.WriteFile(path, []byte("foo"), 0644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func checkFiles(t *testing.T, dir, name string, exist bool) {
+	t.Helper()
+	if _, err := os.Stat(filepath.Join(dir, name)); (err == nil) != exist {
+		t.Errorf("%v exists: %v, want %v", filepath.Join(dir, name), err == nil, exist)
+	}
+}
+
+/* MIT License
+ *
+ * Copyright 2023 Spiegel
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
@@ -0,0 +1,99 @@
+package neo
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+
+	"github.com/goark/apod/ecode"
+	"github.com/goark/apod/nasaapi"
+	"github.com/goark/apod/nasaapi/neows"
+	"github.com/goark/errs"
+)
+
+// Neo is configuration for neo command.
+type Neo struct {
+	*neows.Request
+	rawFlag bool
+}
+
+// New returns new Neo instance.
+func New(cfg *neows.Request, rawFlag bool) *Neo {
+	return &Neo{Request: cfg, rawFlag: rawFlag}
+}
+
+// Do method lists close approaches of near earth objects from NeoWs API.
+// Date window longer than neows.MaxFeedDays is split into some requests (except raw data).
+func (n *Neo) Do(ctx context.Context) (io.ReadCloser, error) {
+	if n == nil || n.Request == nil {
+		return nil, errs.Wrap(ecode.ErrNullPointer)
+	}
+	if n.rawFlag {
+		return n.FeedRawData(ctx)
+	}
+	approaches, err := n.Approaches(ctx)
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	b, err := json.Marshal(approaches)
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return io.NopCloser(bytes.NewReader(b)), nil
+}
+
+// Approaches method gets close approaches in date window of Request.
+func (n *Neo) Approaches(ctx context.Context) ([]*neows.Approach, error) {
+	if n == nil || n.Request == nil {
+		return nil, errs.Wrap(ecode.ErrNullPointer)
+	}
+	if n.StartDate.IsZero() || n.EndDate.IsZero() {
+		feed, err := n.Feed(ctx)
+		if err != nil {
+			return nil, errs.Wrap(err)
+		}
+		return feed.Approaches(), nil
+	}
+	if n.EndDate.Before(n.StartDate.Time) {
+		return nil, errs.Wrap(ecode.ErrEndBeforeStart, errs.WithContext("start_date", n.StartDate), errs.WithContext("end_date", n.EndDate))
+	}
+	approaches := []*neows.Approach{}
+	for start := n.StartDate; !start.After(n.EndDate.Time); start = nasaapi.NewDate(start.AddDate(0, 0, neows.MaxFeedDays+1)) {
+		end := nasaapi.NewDate(start.AddDate(0, 0, neows.MaxFeedDays))
+		if end.After(n.EndDate.Time) {
+			end = n.EndDate
+		}
+		req := *n.Request
+		req.StartDate, req.EndDate = start, end
+		feed, err := req.Feed(ctx)
+		if err != nil {
+			return nil, errs.Wrap(err, errs.WithContext("start_date", start), errs.WithContext("end_date", end))
+		}
+		approaches = append(approaches, feed.Approaches()...)
+	}
+	return approaches, nil
+}
+
+/* MIT License
+ *
+ * Copyright 2023 Spiegel
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
@@ -0,0 +1,66 @@
+package quota
+
+import (
+	"context"
+
+	"github.com/goark/apod/ecode"
+	"github.com/goark/apod/nasaapi"
+	"github.com/goark/apod/nasaapi/apod"
+	"github.com/goark/errs"
+)
+
+// Quota is configuration for quota command.
+type Quota struct {
+	*apod.Request
+}
+
+// New returns new Quota instance.
+func New(cfg *apod.Request) *Quota {
+	return &Quota{Request: cfg}
+}
+
+// Do method gets rate limit information for the API key from NASA API.
+// It requests today's APOD data, so one request of the quota is consumed.
+func (q *Quota) Do(ctx context.Context) (nasaapi.RateLimit, error) {
+	if q == nil || q.Request == nil {
+		return nasaapi.RateLimit{}, errs.Wrap(ecode.ErrNullPointer)
+	}
+	cli := q.Client()
+	r, err := apod.New(apod.WithAPIKey(q.APIKey), apod.WithClient(cli)).GetRawData(ctx)
+	if err != nil {
+		// rate limit headers are also returned with error response (e.g. 429 Too Many Requests)
+		if rl, ok := cli.RateLimit(); ok {
+			return rl, nil
+		}
+		return nasaapi.RateLimit{}, errs.Wrap(err)
+	}
+	r.Close()
+	rl, ok := cli.RateLimit()
+	if !ok {
+		return nasaapi.RateLimit{}, errs.Wrap(ecode.ErrNoRateLimit)
+	}
+	return rl, nil
+}
+
+/* MIT License
+ *
+ * Copyright 2023 Spiegel
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
@@ -0,0 +1,92 @@
+package ssd
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/goark/apod/ecode"
+	"github.com/goark/apod/nasaapi/ssd"
+	"github.com/goark/errs"
+)
+
+// Format is output format of ssd command.
+type Format int
+
+const (
+	FormatJSON  Format = iota // typed JSON data
+	FormatTable               // aligned text table
+	FormatCSV                 // CSV data with header
+)
+
+var formatNames = map[Format]string{
+	FormatJSON:  "json",
+	FormatTable: "table",
+	FormatCSV:   "csv",
+}
+
+// FormatFrom returns Format from string.
+func FormatFrom(s string) (Format, error) {
+	for f, name := range formatNames {
+		if strings.EqualFold(name, s) {
+			return f, nil
+		}
+	}
+	return FormatJSON, errs.Wrap(ecode.ErrInvalidParameter, errs.WithContext("format", s))
+}
+
+// String is Stringer method.
+func (f Format) String() string {
+	if name, ok := formatNames[f]; ok {
+		return name
+	}
+	return fmt.Sprintf("unknown (%d)", int(f))
+}
+
+// Encode function encodes list of records (slice of struct pointers) in the format.
+func Encode(list interface{}, format Format) (io.Reader, error) {
+	buf := &bytes.Buffer{}
+	switch format {
+	case FormatTable:
+		if err := ssd.WriteTable(buf, list); err != nil {
+			return nil, errs.Wrap(err)
+		}
+	case FormatCSV:
+		if err := ssd.WriteCSV(buf, list); err != nil {
+			return nil, errs.Wrap(err)
+		}
+	default:
+		b, err := json.Marshal(list)
+		if err != nil {
+			return nil, errs.Wrap(err)
+		}
+		buf.Write(b)
+		buf.WriteByte('\n')
+	}
+	return buf, nil
+}
+
+/* MIT License
+ *
+ * Copyright 2023 Spiegel
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */